@@ -9,6 +9,7 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"runtime/trace"
 
@@ -27,6 +28,10 @@ import (
 func init() {
 	createDBCmd.Flags().StringP("queryset", "", "", "name of the queryset to use")
 	pushCmd.Flags().BoolP("dry-run", "", false, "dump push request (default: false)")
+	for _, c := range []*cobra.Command{checkCmd, diffCmd, genCmd} {
+		c.Flags().String("format", formatText, "format of the reported problems: text, json, sarif or github")
+	}
+	genCmd.Flags().Bool("watch", false, "regenerate code when the configuration, schema or query files change (default: false)")
 	initCmd.Flags().BoolP("v1", "", false, "generate v1 config yaml file")
 	initCmd.Flags().BoolP("v2", "", true, "generate v2 config yaml file")
 	initCmd.MarkFlagsMutuallyExclusive("v1", "v2")
@@ -44,6 +49,8 @@ func Do(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int
 	rootCmd.AddCommand(diffCmd)
 	rootCmd.AddCommand(genCmd)
 	rootCmd.AddCommand(initCmd)
+	rootCmd.AddCommand(lspCmd)
+	rootCmd.AddCommand(migrateCmd)
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(verifyCmd)
 	rootCmd.AddCommand(pushCmd)
@@ -195,25 +202,48 @@ var genCmd = &cobra.Command{
 		defer trace.StartRegion(cmd.Context(), "generate").End()
 		stderr := cmd.ErrOrStderr()
 		dir, name := getConfigPath(stderr, cmd.Flag("file"))
-		output, err := Generate(cmd.Context(), dir, name, &Options{
-			Env:    ParseEnv(cmd),
-			Stderr: stderr,
-		})
+		opts, err := diagnosticOptions(cmd)
 		if err != nil {
+			fmt.Fprintf(stderr, "error: %s\n", err)
 			os.Exit(1)
 		}
-		defer trace.StartRegion(cmd.Context(), "writefiles").End()
-		for filename, source := range output {
-			os.MkdirAll(filepath.Dir(filename), 0755)
-			if err := os.WriteFile(filename, []byte(source), 0644); err != nil {
-				fmt.Fprintf(stderr, "%s: %s\n", filename, err)
-				return err
+		if watch, _ := cmd.Flags().GetBool("watch"); watch {
+			if opts.diags != nil {
+				fmt.Fprintln(stderr, "error: --format can't be used with --watch")
+				os.Exit(1)
 			}
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+			if err := Watch(ctx, dir, name, opts, func(output map[string]string) error {
+				return writeFiles(stderr, output)
+			}); err != nil {
+				os.Exit(1)
+			}
+			return nil
 		}
-		return nil
+		output, err := Generate(cmd.Context(), dir, name, opts)
+		if werr := opts.WriteDiagnostics(); werr != nil {
+			fmt.Fprintf(stderr, "error writing diagnostics: %s\n", werr)
+		}
+		if err != nil {
+			os.Exit(1)
+		}
+		defer trace.StartRegion(cmd.Context(), "writefiles").End()
+		return writeFiles(stderr, output)
 	},
 }
 
+func writeFiles(stderr io.Writer, output map[string]string) error {
+	for filename, source := range output {
+		os.MkdirAll(filepath.Dir(filename), 0755)
+		if err := os.WriteFile(filename, []byte(source), 0644); err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", filename, err)
+			return err
+		}
+	}
+	return nil
+}
+
 var checkCmd = &cobra.Command{
 	Use:   "compile",
 	Short: "Statically check SQL for syntax and type errors",
@@ -221,10 +251,15 @@ var checkCmd = &cobra.Command{
 		defer trace.StartRegion(cmd.Context(), "compile").End()
 		stderr := cmd.ErrOrStderr()
 		dir, name := getConfigPath(stderr, cmd.Flag("file"))
-		_, err := Generate(cmd.Context(), dir, name, &Options{
-			Env:    ParseEnv(cmd),
-			Stderr: stderr,
-		})
+		opts, err := diagnosticOptions(cmd)
+		if err != nil {
+			fmt.Fprintf(stderr, "error: %s\n", err)
+			os.Exit(1)
+		}
+		_, err = Generate(cmd.Context(), dir, name, opts)
+		if werr := opts.WriteDiagnostics(); werr != nil {
+			fmt.Fprintf(stderr, "error writing diagnostics: %s\n", werr)
+		}
 		if err != nil {
 			os.Exit(1)
 		}
@@ -232,6 +267,22 @@ var checkCmd = &cobra.Command{
 	},
 }
 
+// diagnosticOptions returns the options of a command that reports problems
+// in the format chosen with its --format flag
+func diagnosticOptions(cmd *cobra.Command) (*Options, error) {
+	format, _ := cmd.Flags().GetString("format")
+	diags, err := newDiagnostics(format)
+	if err != nil {
+		return nil, err
+	}
+	return &Options{
+		Env:    ParseEnv(cmd),
+		Stderr: cmd.ErrOrStderr(),
+		Stdout: cmd.OutOrStdout(),
+		diags:  diags,
+	}, nil
+}
+
 func getLines(f []byte) []string {
 	fp := bytes.NewReader(f)
 	scanner := bufio.NewScanner(fp)
@@ -268,11 +319,16 @@ var diffCmd = &cobra.Command{
 		defer trace.StartRegion(cmd.Context(), "diff").End()
 		stderr := cmd.ErrOrStderr()
 		dir, name := getConfigPath(stderr, cmd.Flag("file"))
-		opts := &Options{
-			Env:    ParseEnv(cmd),
-			Stderr: stderr,
+		opts, err := diagnosticOptions(cmd)
+		if err != nil {
+			fmt.Fprintf(stderr, "error: %s\n", err)
+			os.Exit(1)
+		}
+		err = Diff(cmd.Context(), dir, name, opts)
+		if werr := opts.WriteDiagnostics(); werr != nil {
+			fmt.Fprintf(stderr, "error writing diagnostics: %s\n", werr)
 		}
-		if err := Diff(cmd.Context(), dir, name, opts); err != nil {
+		if err != nil {
 			os.Exit(1)
 		}
 		return nil
@@ -80,6 +80,10 @@ func CreateDB(ctx context.Context, dir, filename, querySetName string, o *Option
 	if err != nil {
 		return err
 	}
+	files, err = migrations.Order(files)
+	if err != nil {
+		return err
+	}
 	for _, schema := range files {
 		contents, err := os.ReadFile(schema)
 		if err != nil {
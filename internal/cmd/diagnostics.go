@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/sqlc-dev/sqlc/internal/info"
+	"github.com/sqlc-dev/sqlc/internal/multierr"
+)
+
+// Formats for the diagnostics of generate, compile, vet and diff
+const (
+	formatText   = "text"
+	formatJSON   = "json"
+	formatSARIF  = "sarif"
+	formatGitHub = "github"
+)
+
+// Severities of diagnostics and vet rules
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+	severityInfo    = "info"
+)
+
+// A Diagnostic is a problem found by a command. File is relative to the
+// working directory and empty for problems that aren't tied to a file.
+type Diagnostic struct {
+	File     string `json:"file,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Rule     string `json:"rule,omitempty"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	Package  string `json:"package,omitempty"`
+	Query    string `json:"query,omitempty"`
+}
+
+// diagnostics collects the problems found by a command so they can be written
+// in a machine-readable format once it's done. Commands print problems as
+// text while they run when the collector is nil.
+type diagnostics struct {
+	format string
+	wd     string
+
+	mu   sync.Mutex
+	list []Diagnostic
+}
+
+// newDiagnostics returns nil for the text format
+func newDiagnostics(format string) (*diagnostics, error) {
+	switch format {
+	case "", formatText:
+		return nil, nil
+	case formatJSON, formatSARIF, formatGitHub:
+	default:
+		return nil, fmt.Errorf("unknown format %q: must be one of text, json, sarif or github", format)
+	}
+	wd, _ := os.Getwd()
+	return &diagnostics{format: format, wd: wd}, nil
+}
+
+func (d *diagnostics) add(diag Diagnostic) {
+	if diag.Severity == "" {
+		diag.Severity = severityError
+	}
+	if diag.File != "" && d.wd != "" && filepath.IsAbs(diag.File) {
+		if rel, err := filepath.Rel(d.wd, diag.File); err == nil && !strings.HasPrefix(rel, "..") {
+			diag.File = rel
+		}
+	}
+	diag.File = filepath.ToSlash(diag.File)
+	d.mu.Lock()
+	d.list = append(d.list, diag)
+	d.mu.Unlock()
+}
+
+func (d *diagnostics) addFileErr(pkg string, fileErr *multierr.FileError) {
+	d.add(Diagnostic{
+		File:    fileErr.Filename,
+		Line:    fileErr.Line,
+		Column:  fileErr.Column,
+		Message: fileErr.Err.Error(),
+		Package: pkg,
+	})
+}
+
+// write writes the collected diagnostics. Nothing is written for the text
+// format, which was printed already.
+func (d *diagnostics) write(w io.Writer) error {
+	if d == nil {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	list := d.list
+	if list == nil {
+		list = []Diagnostic{}
+	}
+	// Messages quote SQL, which is easier to read without escaped operators
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	switch d.format {
+	case formatJSON:
+		return enc.Encode(struct {
+			Diagnostics []Diagnostic `json:"diagnostics"`
+		}{list})
+	case formatSARIF:
+		return enc.Encode(sarifLog(list))
+	case formatGitHub:
+		for _, diag := range list {
+			if _, err := fmt.Fprintln(w, githubCommand(diag)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// githubCommand formats a diagnostic as a GitHub Actions workflow command,
+// which shows up as an annotation on the pull request
+func githubCommand(diag Diagnostic) string {
+	var props []string
+	if diag.File != "" {
+		props = append(props, "file="+escapeProperty(diag.File))
+		if diag.Line > 0 {
+			props = append(props, fmt.Sprintf("line=%d", diag.Line))
+		}
+		if diag.Column > 0 {
+			props = append(props, fmt.Sprintf("col=%d", diag.Column))
+		}
+	}
+	title := "sqlc"
+	if diag.Rule != "" {
+		title = diag.Rule
+	}
+	props = append(props, "title="+escapeProperty(title))
+	msg := diag.Message
+	if diag.Query != "" {
+		msg = diag.Query + ": " + msg
+	}
+	command := diag.Severity
+	if command == severityInfo {
+		command = "notice"
+	}
+	return fmt.Sprintf("::%s %s::%s", command, strings.Join(props, ","), escapeData(msg))
+}
+
+func escapeData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+func escapeProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
+
+// The subset of SARIF 2.1.0 needed to report results, see
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type sarif struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId,omitempty"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+func sarifLog(list []Diagnostic) sarif {
+	driver := sarifDriver{
+		Name:           "sqlc",
+		Version:        info.Version,
+		InformationURI: "https://sqlc.dev",
+	}
+	results := []sarifResult{}
+	for _, diag := range list {
+		if diag.Rule != "" && !slices.ContainsFunc(driver.Rules, func(r sarifRule) bool { return r.ID == diag.Rule }) {
+			driver.Rules = append(driver.Rules, sarifRule{ID: diag.Rule})
+		}
+		msg := diag.Message
+		if diag.Query != "" {
+			msg = diag.Query + ": " + msg
+		}
+		level := diag.Severity
+		if level == severityInfo {
+			level = "note"
+		}
+		result := sarifResult{
+			RuleID:  diag.Rule,
+			Level:   level,
+			Message: sarifMessage{Text: msg},
+		}
+		if diag.File != "" {
+			loc := sarifLocation{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: diag.File},
+			}}
+			if diag.Line > 0 {
+				loc.PhysicalLocation.Region = &sarifRegion{StartLine: diag.Line, StartColumn: diag.Column}
+			}
+			result.Locations = append(result.Locations, loc)
+		}
+		results = append(results, result)
+	}
+	return sarif{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+}
@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/info"
+	"github.com/sqlc-dev/sqlc/internal/multierr"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the diagnostics formats")
+
+func testDiagnostics(t *testing.T, format string) *diagnostics {
+	t.Helper()
+	// A fixed working directory keeps the relative paths stable
+	wd, err := filepath.Abs("work")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &diagnostics{format: format, wd: wd}
+	d.addFileErr("db", &multierr.FileError{
+		Filename: filepath.Join(wd, "query", "authors.sql"),
+		Line:     3,
+		Column:   15,
+		Err:      errors.New(`column "nme" does not exist`),
+	})
+	d.add(Diagnostic{
+		File:     filepath.Join(wd, "query", "books, 50%: all.sql"),
+		Line:     7,
+		Rule:     "no-delete:without-where",
+		Severity: severityWarning,
+		Message:  "DELETE FROM books\r\nmust have a WHERE clause: 100% of rows <& \"quoted\" 'single'>",
+		Package:  "db",
+		Query:    "DeleteBooks",
+	})
+	d.add(Diagnostic{
+		File:     "schema.sql",
+		Rule:     "no-delete:without-where",
+		Severity: severityInfo,
+		Message:  "tab\tand unicode ✓",
+	})
+	d.add(Diagnostic{
+		File:    filepath.Join(filepath.Dir(wd), "outside.sql"),
+		Message: "error generating code: invalid output path",
+		Package: "db",
+	})
+	d.add(Diagnostic{
+		Message: "relation \"authors\" does not exist",
+		Package: "db",
+	})
+	return d
+}
+
+func TestDiagnosticsFormats(t *testing.T) {
+	for _, format := range []string{formatJSON, formatSARIF, formatGitHub} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := testDiagnostics(t, format).write(&buf); err != nil {
+				t.Fatal(err)
+			}
+			// The golden files don't change with every release
+			got := strings.ReplaceAll(buf.String(), info.Version, "VERSION")
+			// Paths outside the working directory stay absolute
+			outside, _ := filepath.Abs("outside.sql")
+			got = strings.ReplaceAll(got, filepath.ToSlash(outside), "/abs/outside.sql")
+			golden := filepath.Join("testdata", "diagnostics", format+".golden")
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(string(want), got); diff != "" {
+				t.Errorf("%s output mismatch (-want +got):\n%s", format, diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsEmpty(t *testing.T) {
+	for format, want := range map[string]string{
+		formatJSON:   "{\n  \"diagnostics\": []\n}\n",
+		formatGitHub: "",
+	} {
+		var buf bytes.Buffer
+		if err := (&diagnostics{format: format}).write(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != want {
+			t.Errorf("%s: got %q, want %q", format, buf.String(), want)
+		}
+	}
+}
+
+func TestGitHubEscaping(t *testing.T) {
+	for _, tc := range []struct {
+		diag Diagnostic
+		want string
+	}{
+		{
+			Diagnostic{Severity: severityError, Message: "100% done"},
+			"::error title=sqlc::100%25 done",
+		},
+		{
+			Diagnostic{Severity: severityWarning, Message: "line one\r\nline two\n"},
+			"::warning title=sqlc::line one%0D%0Aline two%0A",
+		},
+		{
+			Diagnostic{Severity: severityInfo, Message: "a: b, c", Rule: "rule:one,two"},
+			"::notice title=rule%3Aone%2Ctwo::a: b, c",
+		},
+		{
+			Diagnostic{Severity: severityError, File: "a,b:%.sql", Line: 1, Column: 2, Message: "m", Query: "Q"},
+			"::error file=a%2Cb%3A%25.sql,line=1,col=2,title=sqlc::Q: m",
+		},
+		{
+			Diagnostic{Severity: severityError, Column: 2, Message: "no file, no position"},
+			"::error title=sqlc::no file, no position",
+		},
+	} {
+		if got := githubCommand(tc.diag); got != tc.want {
+			t.Errorf("got %q, want %q", got, tc.want)
+		}
+	}
+}
+
+func TestDiagnosticsInvalidConfig(t *testing.T) {
+	configs := map[string]string{
+		// Parsing fails
+		"version": "sql: []\n",
+		// Validation fails
+		"database": `version: "2"
+sql:
+  - engine: postgresql
+    schema: schema.sql
+    queries: query.sql
+    database: {}
+    gen:
+      go:
+        package: db
+        out: db
+`,
+	}
+	for _, format := range []string{formatJSON, formatSARIF, formatGitHub} {
+		t.Run(format, func(t *testing.T) {
+			var got strings.Builder
+			for _, name := range []string{"version", "database"} {
+				dir := t.TempDir()
+				if err := os.WriteFile(filepath.Join(dir, "sqlc.yaml"), []byte(configs[name]), 0644); err != nil {
+					t.Fatal(err)
+				}
+				var stderr, stdout bytes.Buffer
+				o := &Options{
+					Env:    Env{NoRemote: true},
+					Stderr: &stderr,
+					Stdout: &stdout,
+					diags:  &diagnostics{format: format, wd: dir},
+				}
+				err := Vet(context.Background(), dir, "", o)
+				if err == nil {
+					t.Fatalf("%s: expected an error", name)
+				}
+				if stderr.Len() > 0 {
+					t.Errorf("%s: the error was printed as text: %s", name, stderr.String())
+				}
+				if err := o.WriteDiagnostics(); err != nil {
+					t.Fatal(err)
+				}
+				got.WriteString(strings.ReplaceAll(stdout.String(), info.Version, "VERSION"))
+			}
+			golden := filepath.Join("testdata", "diagnostics", "invalid_config."+format+".golden")
+			if *update {
+				if err := os.WriteFile(golden, []byte(got.String()), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(string(want), got.String()); diff != "" {
+				t.Errorf("%s output mismatch (-want +got):\n%s", format, diff)
+			}
+		})
+	}
+}
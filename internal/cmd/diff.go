@@ -7,6 +7,7 @@ import (
 	"os"
 	"runtime/trace"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/cubicdaiya/gonp"
@@ -32,12 +33,19 @@ func Diff(ctx context.Context, dir, name string, opts *Options) error {
 		source := output[filename]
 		if _, err := os.Stat(filename); errors.Is(err, os.ErrNotExist) {
 			errored = true
+			if opts.diags != nil {
+				opts.diags.add(Diagnostic{File: filename, Message: "generated file does not exist"})
+			}
 			// stdout message
 			continue
 		}
 		existing, err := os.ReadFile(filename)
 		if err != nil {
 			errored = true
+			if opts.diags != nil {
+				opts.diags.add(Diagnostic{File: filename, Message: err.Error()})
+				continue
+			}
 			fmt.Fprintf(stderr, "%s: %s\n", filename, err)
 			continue
 		}
@@ -47,6 +55,15 @@ func Diff(ctx context.Context, dir, name string, opts *Options) error {
 
 		if len(uniHunks) > 0 {
 			errored = true
+			if opts.diags != nil {
+				// Point at the first hunk, context lines included
+				opts.diags.add(Diagnostic{
+					File:    filename,
+					Line:    hunkStart(uniHunks[0].SprintDiffRange()),
+					Message: "generated file is out of date",
+				})
+				continue
+			}
 			fmt.Fprintf(stderr, "--- a%s\n", strings.TrimPrefix(filename, dir))
 			fmt.Fprintf(stderr, "+++ b%s\n", strings.TrimPrefix(filename, dir))
 			diff.FprintUniHunks(stderr, uniHunks)
@@ -57,3 +74,14 @@ func Diff(ctx context.Context, dir, name string, opts *Options) error {
 	}
 	return nil
 }
+
+// hunkStart returns the first line of the original file in a hunk header like
+// "@@ -3,7 +3,8 @@", whose line count is left out for single lines: "@@ -3 +3 @@"
+func hunkStart(header string) int {
+	rng, _ := strings.CutPrefix(header, "@@ -")
+	if i := strings.IndexAny(rng, ", "); i >= 0 {
+		rng = rng[:i]
+	}
+	line, _ := strconv.Atoi(rng)
+	return line
+}
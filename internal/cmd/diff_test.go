@@ -0,0 +1,19 @@
+package cmd
+
+import "testing"
+
+func TestHunkStart(t *testing.T) {
+	for _, tc := range []struct {
+		header string
+		line   int
+	}{
+		{"@@ -3,7 +3,8 @@\n", 3},
+		{"@@ -3 +3 @@\n", 3},
+		{"@@ -12 +12,2 @@", 12},
+		{"@@ -0,0 +1,2 @@", 0},
+	} {
+		if got := hunkStart(tc.header); got != tc.line {
+			t.Errorf("hunkStart(%q) = %d; want %d", tc.header, got, tc.line)
+		}
+	}
+}
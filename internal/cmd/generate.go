@@ -64,7 +64,28 @@ func findPlugin(conf config.Config, name string) (*config.Plugin, error) {
 	return nil, fmt.Errorf("plugin not found")
 }
 
+// A configError is a problem reading the configuration file at path. Msg is
+// what readConfig printed for it.
+type configError struct {
+	path string
+	msg  string
+	err  error
+}
+
+func (e *configError) Error() string {
+	return e.err.Error()
+}
+
+func (e *configError) Unwrap() error {
+	return e.err
+}
+
 func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config, error) {
+	fail := func(path, msg string, err error) (string, *config.Config, error) {
+		fmt.Fprintln(stderr, msg)
+		return path, nil, &configError{path: path, msg: msg, err: err}
+	}
+
 	configPath := ""
 	if filename != "" {
 		configPath = filepath.Join(dir, filename)
@@ -86,13 +107,11 @@ func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config,
 		}
 
 		if yamlMissing && ymlMissing && jsonMissing {
-			fmt.Fprintln(stderr, "error parsing configuration files. sqlc.(yaml|yml) or sqlc.json: file does not exist")
-			return "", nil, errors.New("config file missing")
+			return fail("", "error parsing configuration files. sqlc.(yaml|yml) or sqlc.json: file does not exist", errors.New("config file missing"))
 		}
 
 		if (!yamlMissing || !ymlMissing) && !jsonMissing {
-			fmt.Fprintln(stderr, "error: both sqlc.json and sqlc.(yaml|yml) files present")
-			return "", nil, errors.New("sqlc.json and sqlc.(yaml|yml) present")
+			return fail("", "error: both sqlc.json and sqlc.(yaml|yml) files present", errors.New("sqlc.json and sqlc.(yaml|yml) present"))
 		}
 
 		if jsonMissing {
@@ -109,8 +128,7 @@ func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config,
 	base := filepath.Base(configPath)
 	file, err := os.Open(configPath)
 	if err != nil {
-		fmt.Fprintf(stderr, "error parsing %s: file does not exist\n", base)
-		return "", nil, err
+		return fail(configPath, fmt.Sprintf("error parsing %s: file does not exist", base), err)
 	}
 	defer file.Close()
 
@@ -124,8 +142,7 @@ func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config,
 		case config.ErrNoPackages:
 			fmt.Fprint(stderr, errMessageNoPackages)
 		}
-		fmt.Fprintf(stderr, "error parsing %s: %s\n", base, err)
-		return "", nil, err
+		return fail(configPath, fmt.Sprintf("error parsing %s: %s", base, err), err)
 	}
 
 	return configPath, &conf, nil
@@ -140,14 +157,7 @@ func Generate(ctx context.Context, dir, filename string, o *Options) (map[string
 		return nil, err
 	}
 
-	base := filepath.Base(configPath)
-	if err := config.Validate(conf); err != nil {
-		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
-		return nil, err
-	}
-
-	if err := e.Validate(conf); err != nil {
-		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
+	if err := o.validateConfig(configPath, conf); err != nil {
 		return nil, err
 	}
 
@@ -159,6 +169,7 @@ func Generate(ctx context.Context, dir, filename string, o *Options) (map[string
 	g := &generator{
 		dir:    dir,
 		output: map[string]string{},
+		store:  newGenerateCache(e.Debug),
 	}
 
 	if err := processQuerySets(ctx, g, conf, dir, o); err != nil {
@@ -172,6 +183,7 @@ type generator struct {
 	m      sync.Mutex
 	dir    string
 	output map[string]string
+	store  *generateCache
 }
 
 func (g *generator) Pairs(ctx context.Context, conf *config.Config) []OutputPair {
@@ -200,21 +212,39 @@ func (g *generator) Pairs(ctx context.Context, conf *config.Config) []OutputPair
 }
 
 func (g *generator) ProcessResult(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result) error {
+	_, err := g.process(ctx, combo, sql, result)
+	return err
+}
+
+func (g *generator) process(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result) (*generatedFiles, error) {
 	out, resp, err := codegen(ctx, combo, sql, result)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	files := map[string]string{}
+	files := &generatedFiles{Out: out, Files: map[string]string{}}
 	for _, file := range resp.Files {
-		files[file.Name] = string(file.Contents)
+		files.Files[file.Name] = string(file.Contents)
+	}
+	if err := g.restore(files); err != nil {
+		return nil, err
 	}
+	return files, nil
+}
+
+func (g *generator) cache() *generateCache {
+	return g.store
+}
+
+// restore adds generated files to the output
+func (g *generator) restore(files *generatedFiles) error {
 	g.m.Lock()
+	defer g.m.Unlock()
 
 	// out is specified by the user, not a plugin
-	absout := filepath.Join(g.dir, out)
+	absout := filepath.Join(g.dir, files.Out)
 
-	for n, source := range files {
-		filename := filepath.Join(g.dir, out, n)
+	for n, source := range files.Files {
+		filename := filepath.Join(g.dir, files.Out, n)
 		// filepath.Join calls filepath.Clean which should remove all "..", but
 		// double check to make sure
 		if strings.Contains(filename, "..") {
@@ -226,7 +256,6 @@ func (g *generator) ProcessResult(ctx context.Context, combo config.CombinedSett
 		}
 		g.output[filename] = source
 	}
-	g.m.Unlock()
 	return nil
 }
 
@@ -293,8 +322,57 @@ func remoteGenerate(ctx context.Context, configPath string, conf *config.Config,
 	return output, nil
 }
 
-func parse(ctx context.Context, name, dir string, sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser, stderr io.Writer) (*compiler.Result, bool) {
+func parse(ctx context.Context, cache *parseCache, name, dir string, sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser, diags *diagnostics, stderr io.Writer) (*compiler.Result, bool) {
 	defer trace.StartRegion(ctx, "parse").End()
+	result, err := cache.compile(ctx, sql, combo, parserOpts)
+	if err != nil {
+		var cerr *compileError
+		if !errors.As(err, &cerr) || cerr.stage == "compiler" {
+			if diags != nil {
+				diags.add(Diagnostic{Message: fmt.Sprintf("error creating compiler: %s", err), Package: name})
+				return nil, true
+			}
+			fmt.Fprintf(stderr, "error creating compiler: %s\n", err)
+			return nil, true
+		}
+		if diags != nil {
+			if parserErr, ok := cerr.err.(*multierr.Error); ok {
+				for _, fileErr := range parserErr.Errs() {
+					diags.addFileErr(name, fileErr)
+				}
+			} else {
+				diags.add(Diagnostic{Message: fmt.Sprintf("error parsing %s: %s", cerr.stage, cerr.err), Package: name})
+			}
+			return nil, true
+		}
+		fmt.Fprintf(stderr, "# package %s\n", name)
+		if parserErr, ok := cerr.err.(*multierr.Error); ok {
+			for _, fileErr := range parserErr.Errs() {
+				printFileErr(stderr, dir, fileErr)
+			}
+		} else {
+			fmt.Fprintf(stderr, "error parsing %s: %s\n", cerr.stage, cerr.err)
+		}
+		return nil, true
+	}
+	return result, false
+}
+
+// compileError records which step of compile failed
+type compileError struct {
+	stage string // compiler, schema or queries
+	err   error
+}
+
+func (e *compileError) Error() string {
+	return e.err.Error()
+}
+
+func (e *compileError) Unwrap() error {
+	return e.err
+}
+
+func compile(ctx context.Context, sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser) (*compiler.Result, error) {
 	c, err := compiler.NewCompiler(sql, combo)
 	defer func() {
 		if c != nil {
@@ -302,35 +380,37 @@ func parse(ctx context.Context, name, dir string, sql config.SQL, combo config.C
 		}
 	}()
 	if err != nil {
-		fmt.Fprintf(stderr, "error creating compiler: %s\n", err)
-		return nil, true
+		return nil, &compileError{stage: "compiler", err: err}
 	}
 	if err := c.ParseCatalog(sql.Schema); err != nil {
-		fmt.Fprintf(stderr, "# package %s\n", name)
-		if parserErr, ok := err.(*multierr.Error); ok {
-			for _, fileErr := range parserErr.Errs() {
-				printFileErr(stderr, dir, fileErr)
-			}
-		} else {
-			fmt.Fprintf(stderr, "error parsing schema: %s\n", err)
-		}
-		return nil, true
+		return nil, &compileError{stage: "schema", err: err}
 	}
 	if parserOpts.Debug.DumpCatalog {
 		debug.Dump(c.Catalog())
 	}
 	if err := c.ParseQueries(sql.Queries, parserOpts); err != nil {
-		fmt.Fprintf(stderr, "# package %s\n", name)
-		if parserErr, ok := err.(*multierr.Error); ok {
-			for _, fileErr := range parserErr.Errs() {
-				printFileErr(stderr, dir, fileErr)
-			}
-		} else {
-			fmt.Fprintf(stderr, "error parsing queries: %s\n", err)
-		}
-		return nil, true
+		return nil, &compileError{stage: "queries", err: err}
+	}
+	return c.Result(), nil
+}
+
+// pluginHandler returns the connection used to call a process or WASM plugin
+func pluginHandler(plug *config.Plugin) (grpc.ClientConnInterface, error) {
+	switch {
+	case plug.Process != nil:
+		return &process.Runner{
+			Cmd: plug.Process.Cmd,
+			Env: plug.Env,
+		}, nil
+	case plug.WASM != nil:
+		return &wasm.Runner{
+			URL:    plug.WASM.URL,
+			SHA256: plug.WASM.SHA256,
+			Env:    plug.Env,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported plugin type")
 	}
-	return c.Result(), false
 }
 
 func codegen(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result) (string, *plugin.GenerateResponse, error) {
@@ -345,21 +425,9 @@ func codegen(ctx context.Context, combo config.CombinedSettings, sql OutputPair,
 		if err != nil {
 			return "", nil, fmt.Errorf("plugin not found: %s", err)
 		}
-
-		switch {
-		case plug.Process != nil:
-			handler = &process.Runner{
-				Cmd: plug.Process.Cmd,
-				Env: plug.Env,
-			}
-		case plug.WASM != nil:
-			handler = &wasm.Runner{
-				URL:    plug.WASM.URL,
-				SHA256: plug.WASM.SHA256,
-				Env:    plug.Env,
-			}
-		default:
-			return "", nil, fmt.Errorf("unsupported plugin type")
+		handler, err = pluginHandler(plug)
+		if err != nil {
+			return "", nil, err
 		}
 
 		opts, err := convert.YAMLtoJSON(sql.Plugin.Options)
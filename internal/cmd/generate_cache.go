@@ -0,0 +1,357 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/cache"
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/debug"
+	"github.com/sqlc-dev/sqlc/internal/info"
+	"github.com/sqlc-dev/sqlc/internal/opts"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+// generateCache keeps the results of `sqlc generate` between runs. Output
+// pairs whose configuration, schema, queries, plugin and sqlc build haven't
+// changed are restored without compiling or running codegen. When only some
+// query files changed, the compiled queries of the others are reused.
+//
+// Records are content addressed, so a stale record is never read, and only
+// the results of successful runs are stored.
+type generateCache struct {
+	dir   string
+	build string
+}
+
+// generatedFiles is the output of a single output pair
+type generatedFiles struct {
+	Out   string            `json:"out"`
+	Files map[string]string `json:"files"`
+}
+
+// newGenerateCache returns nil if the cache is disabled or its directory
+// can't be created
+func newGenerateCache(d opts.Debug) *generateCache {
+	if !d.GenerateCache {
+		return nil
+	}
+	dir, err := cache.GenerateDir()
+	if err != nil {
+		return nil
+	}
+	return &generateCache{dir: dir, build: buildID()}
+}
+
+// buildID identifies the running sqlc binary. Development builds all share a
+// version, so the size and modification time of the executable are included
+// as well.
+func buildID() string {
+	id := info.Version
+	exe, err := os.Executable()
+	if err != nil {
+		return id
+	}
+	if fi, err := os.Stat(exe); err == nil {
+		id += fmt.Sprintf(" %s %d %d", exe, fi.Size(), fi.ModTime().UnixNano())
+	}
+	return id
+}
+
+// cacheable reports whether compiling sql only depends on its files. Queries
+// analyzed against a database reached by URI depend on that database too.
+func cacheable(sql config.SQL) bool {
+	return sql.Database == nil || sql.Database.Managed
+}
+
+// outputKey identifies the generated files of an output pair. Process
+// plugins are external programs whose output can't be tracked, so they're
+// never cached. WASM plugins are identified by their module and the values
+// of the environment variables they're passed.
+func (gc *generateCache) outputKey(combo config.CombinedSettings, pair OutputPair, parserOpts opts.Parser) (string, bool) {
+	if gc == nil || !cacheable(pair.SQL) {
+		return "", false
+	}
+	var plug *config.Plugin
+	var module string
+	var env []string
+	if pair.Plugin != nil {
+		p, err := findPlugin(combo.Global, pair.Plugin.Plugin)
+		if err != nil || p.WASM == nil {
+			return "", false
+		}
+		sum, ok := wasmChecksum(p)
+		if !ok {
+			return "", false
+		}
+		for _, name := range p.Env {
+			env = append(env, name+"="+os.Getenv(name))
+		}
+		plug, module = p, sum
+	}
+	inputs, err := parseKey(pair.SQL, combo, parserOpts)
+	if err != nil {
+		return "", false
+	}
+	settings := struct {
+		Build     string
+		Inputs    string
+		Package   config.SQL
+		Gen       config.SQLGen
+		Codegen   *config.Codegen
+		Plugin    *config.Plugin
+		Module    string
+		Env       []string
+		Overrides config.Overrides
+	}{
+		Build:     gc.build,
+		Inputs:    inputs,
+		Package:   pair.SQL,
+		Gen:       pair.Gen,
+		Codegen:   pair.Plugin,
+		Plugin:    plug,
+		Module:    module,
+		Env:       env,
+		Overrides: combo.Global.Overrides,
+	}
+	h := sha256.New()
+	if err := json.NewEncoder(h).Encode(settings); err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("output-%x", h.Sum(nil)), true
+}
+
+// wasmChecksum returns the checksum of the module of a WASM plugin. Modules
+// without a pinned sha256 can be rebuilt in place, so local ones are hashed on
+// every run and remote ones aren't cached.
+func wasmChecksum(p *config.Plugin) (string, bool) {
+	if p.WASM.SHA256 != "" {
+		return p.WASM.SHA256, true
+	}
+	path, ok := strings.CutPrefix(p.WASM.URL, "file://")
+	if !ok {
+		return "", false
+	}
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(blob)), true
+}
+
+func (gc *generateCache) loadOutput(key string) (*generatedFiles, bool) {
+	var out generatedFiles
+	if !gc.load(key, &out) {
+		return nil, false
+	}
+	return &out, true
+}
+
+func (gc *generateCache) storeOutput(key string, out *generatedFiles) {
+	gc.store(key, out)
+}
+
+// compile returns the compiler result for sql. Query files that are unchanged
+// since an earlier run aren't compiled again, only the schema and the changed
+// files are. If compiling them fails, or the queries can't be combined, the
+// whole package is compiled so that errors are reported as usual.
+func (gc *generateCache) compile(ctx context.Context, sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser) (*compiler.Result, error) {
+	if gc == nil || !cacheable(sql) {
+		return compile(ctx, sql, combo, parserOpts)
+	}
+	files, keys, err := gc.queryKeys(sql, combo, parserOpts)
+	if err != nil {
+		return compile(ctx, sql, combo, parserOpts)
+	}
+
+	cached := make([][]*compiler.Query, len(files))
+	found := make([]bool, len(files))
+	var changed []string
+	for i := range files {
+		found[i] = gc.load(keys[i], &cached[i])
+		if !found[i] {
+			changed = append(changed, files[i])
+		}
+	}
+	if len(changed) == len(files) {
+		result, err := compile(ctx, sql, combo, parserOpts)
+		if err == nil {
+			gc.storeQueries(files, keys, result.Queries)
+		}
+		return result, err
+	}
+
+	partial := sql
+	partial.Queries = changed
+	c, err := compiler.NewCompiler(partial, combo)
+	defer func() {
+		if c != nil {
+			c.Close(ctx)
+		}
+	}()
+	if err != nil {
+		return compile(ctx, sql, combo, parserOpts)
+	}
+	if err := c.ParseCatalog(sql.Schema); err != nil {
+		return compile(ctx, sql, combo, parserOpts)
+	}
+	if parserOpts.Debug.DumpCatalog {
+		debug.Dump(c.Catalog())
+	}
+	fresh := map[string][]*compiler.Query{}
+	if len(changed) > 0 {
+		if err := c.ParseQueries(changed, parserOpts); err != nil {
+			return compile(ctx, sql, combo, parserOpts)
+		}
+		for _, q := range c.Result().Queries {
+			fresh[q.Metadata.Filename] = append(fresh[q.Metadata.Filename], q)
+		}
+	}
+
+	var queries []*compiler.Query
+	names := map[string]struct{}{}
+	for i, file := range files {
+		qs := cached[i]
+		if !found[i] {
+			qs = fresh[filepath.Base(file)]
+		}
+		for _, q := range qs {
+			if q.Metadata.Name != "" {
+				if _, exists := names[q.Metadata.Name]; exists {
+					return compile(ctx, sql, combo, parserOpts)
+				}
+				names[q.Metadata.Name] = struct{}{}
+			}
+			queries = append(queries, q)
+		}
+	}
+	if len(queries) == 0 {
+		return compile(ctx, sql, combo, parserOpts)
+	}
+	gc.storeQueries(files, keys, queries)
+	return &compiler.Result{
+		Catalog: c.Catalog(),
+		Queries: queries,
+	}, nil
+}
+
+// queryKeys returns the query files of sql and a key for the compiled queries
+// of each. A key covers the build, the settings and schema of the package and
+// the path and contents of the file. Queries are matched to their file by
+// base name, so packages with two query files of the same name aren't
+// cached.
+func (gc *generateCache) queryKeys(sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser) ([]string, []string, error) {
+	schema := sql
+	schema.Queries = nil
+	base, err := parseKey(schema, combo, parserOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+	files, err := sqlpath.Glob(sql.Queries)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]string, len(files))
+	seen := map[string]struct{}{}
+	for i, file := range files {
+		if _, exists := seen[filepath.Base(file)]; exists {
+			return nil, nil, fmt.Errorf("duplicate query file name: %s", filepath.Base(file))
+		}
+		seen[filepath.Base(file)] = struct{}{}
+		blob, err := os.ReadFile(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		h := sha256.New()
+		fmt.Fprintf(h, "%s\n%s\n%s %x\n", gc.build, base, file, sha256.Sum256(blob))
+		keys[i] = fmt.Sprintf("queries-%x", h.Sum(nil))
+	}
+	return files, keys, nil
+}
+
+// storeQueries stores the compiled queries of each file. Only what codegen
+// needs is kept: the parsed statements, used by vet, and the parts of
+// column types beyond their names are dropped.
+func (gc *generateCache) storeQueries(files, keys []string, queries []*compiler.Query) {
+	byFile := map[string][]*compiler.Query{}
+	for _, q := range queries {
+		stored := *q
+		stored.RawStmt = nil
+		stored.Columns = storedColumns(q.Columns)
+		stored.Params = make([]compiler.Parameter, len(q.Params))
+		for i, p := range q.Params {
+			stored.Params[i] = compiler.Parameter{Number: p.Number, Column: storedColumn(p.Column)}
+		}
+		byFile[q.Metadata.Filename] = append(byFile[q.Metadata.Filename], &stored)
+	}
+	for i, file := range files {
+		qs := byFile[filepath.Base(file)]
+		if qs == nil {
+			qs = []*compiler.Query{}
+		}
+		gc.store(keys[i], qs)
+	}
+}
+
+func storedColumns(cols []*compiler.Column) []*compiler.Column {
+	if cols == nil {
+		return nil
+	}
+	stored := make([]*compiler.Column, len(cols))
+	for i, c := range cols {
+		stored[i] = storedColumn(c)
+	}
+	return stored
+}
+
+func storedColumn(c *compiler.Column) *compiler.Column {
+	if c == nil {
+		return nil
+	}
+	stored := *c
+	if c.Type != nil {
+		stored.Type = &ast.TypeName{
+			Catalog: c.Type.Catalog,
+			Schema:  c.Type.Schema,
+			Name:    c.Type.Name,
+		}
+	}
+	return &stored
+}
+
+func (gc *generateCache) load(key string, v any) bool {
+	blob, err := os.ReadFile(filepath.Join(gc.dir, key+".json"))
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(blob, v) == nil
+}
+
+// store writes a record to a temporary file first, so concurrent runs never
+// read a partial record. The cache is best effort and errors are ignored.
+func (gc *generateCache) store(key string, v any) {
+	blob, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(gc.dir, key+".*.tmp")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(blob); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	os.Rename(tmp.Name(), filepath.Join(gc.dir, key+".json"))
+}
@@ -0,0 +1,357 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	sqlcopts "github.com/sqlc-dev/sqlc/internal/opts"
+)
+
+const cacheSchema = `
+CREATE TYPE status AS ENUM ('open', 'closed');
+
+CREATE TABLE authors (
+	id BIGSERIAL PRIMARY KEY,
+	name TEXT NOT NULL,
+	bio TEXT,
+	status status NOT NULL,
+	tags TEXT[] NOT NULL
+);
+`
+
+const cacheAuthors = `
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+
+-- name: ListAuthors :many
+SELECT id, name, bio FROM authors WHERE status = $1 AND $2::text = ANY(tags);
+`
+
+const cacheBooks = `
+-- name: CreateAuthor :one
+INSERT INTO authors (name, bio, status, tags) VALUES ($1, $2, $3, $4) RETURNING *;
+`
+
+// cachePackage writes a package with two query files to a new directory
+func cachePackage(t *testing.T) (string, OutputPair, config.CombinedSettings) {
+	t.Helper()
+	dir := t.TempDir()
+	writeCacheFile(t, dir, "schema.sql", cacheSchema)
+	writeCacheFile(t, dir, "authors.sql", cacheAuthors)
+	writeCacheFile(t, dir, "books.sql", cacheBooks)
+	sql := config.SQL{
+		Engine:  config.EnginePostgreSQL,
+		Schema:  []string{filepath.Join(dir, "schema.sql")},
+		Queries: []string{filepath.Join(dir, "authors.sql"), filepath.Join(dir, "books.sql")},
+		Gen: config.SQLGen{
+			Go: &opts.Options{Package: "db", Out: "db", EmitJsonTags: true},
+		},
+	}
+	pair := OutputPair{SQL: sql, Gen: sql.Gen}
+	return dir, pair, config.Combine(config.Config{SQL: []config.SQL{sql}}, sql)
+}
+
+func writeCacheFile(t *testing.T, dir, name, contents string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testGenerateCache(t *testing.T) *generateCache {
+	return &generateCache{dir: t.TempDir(), build: "test"}
+}
+
+func TestGenerateCacheOutputKey(t *testing.T) {
+	dir, pair, combo := cachePackage(t)
+	gc := testGenerateCache(t)
+
+	key := func(t *testing.T, gc *generateCache, combo config.CombinedSettings, pair OutputPair) string {
+		t.Helper()
+		k, ok := gc.outputKey(combo, pair, sqlcopts.Parser{})
+		if !ok {
+			t.Fatal("output pair isn't cacheable")
+		}
+		return k
+	}
+	base := key(t, gc, combo, pair)
+	if k := key(t, gc, combo, pair); k != base {
+		t.Fatalf("key isn't stable: %s != %s", k, base)
+	}
+
+	files := &generatedFiles{Out: "db", Files: map[string]string{"models.go": "package db\n"}}
+	gc.storeOutput(base, files)
+	loaded, ok := gc.loadOutput(base)
+	if !ok {
+		t.Fatal("stored output wasn't found")
+	}
+	if diff := cmp.Diff(files, loaded); diff != "" {
+		t.Errorf("stored output mismatch:\n%s", diff)
+	}
+
+	t.Run("Build", func(t *testing.T) {
+		other := &generateCache{dir: gc.dir, build: "other"}
+		if key(t, other, combo, pair) == base {
+			t.Error("sqlc build didn't change the key")
+		}
+	})
+
+	t.Run("Gen", func(t *testing.T) {
+		gen := pair
+		gen.Gen.Go = &opts.Options{Package: "db", Out: "db"}
+		if key(t, gc, combo, gen) == base {
+			t.Error("codegen options didn't change the key")
+		}
+	})
+
+	t.Run("Overrides", func(t *testing.T) {
+		overrides := combo
+		overrides.Global.Overrides.Go = &opts.GlobalOptions{Rename: map[string]string{"bio": "Biography"}}
+		if key(t, gc, overrides, pair) == base {
+			t.Error("global overrides didn't change the key")
+		}
+	})
+
+	t.Run("Database", func(t *testing.T) {
+		db := pair
+		db.SQL.Database = &config.Database{URI: "postgres://localhost/authors"}
+		if _, ok := gc.outputKey(combo, db, sqlcopts.Parser{}); ok {
+			t.Error("output analyzed against a database was cacheable")
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		var disabled *generateCache
+		if _, ok := disabled.outputKey(combo, pair, sqlcopts.Parser{}); ok {
+			t.Error("disabled cache returned a key")
+		}
+		if newGenerateCache(sqlcopts.DebugFromString("generatecache=0")) != nil {
+			t.Error("generatecache=0 didn't disable the cache")
+		}
+	})
+
+	// Files are edited last, as they're shared by the subtests above
+	t.Run("Schema", func(t *testing.T) {
+		writeCacheFile(t, dir, "schema.sql", cacheSchema+"\nCREATE TABLE books (id BIGSERIAL PRIMARY KEY);\n")
+		if key(t, gc, combo, pair) == base {
+			t.Error("schema change didn't change the key")
+		}
+		writeCacheFile(t, dir, "schema.sql", cacheSchema)
+	})
+
+	t.Run("Queries", func(t *testing.T) {
+		writeCacheFile(t, dir, "books.sql", cacheBooks+"\n-- name: DeleteAuthor :exec\nDELETE FROM authors WHERE id = $1;\n")
+		if key(t, gc, combo, pair) == base {
+			t.Error("query change didn't change the key")
+		}
+		writeCacheFile(t, dir, "books.sql", cacheBooks)
+		if key(t, gc, combo, pair) != base {
+			t.Error("restoring the queries didn't restore the key")
+		}
+	})
+}
+
+func TestGenerateCacheWASMPlugin(t *testing.T) {
+	dir, pair, combo := cachePackage(t)
+	gc := testGenerateCache(t)
+	module := filepath.Join(dir, "plugin.wasm")
+	writeCacheFile(t, dir, "plugin.wasm", "\x00asm v1")
+
+	withPlugin := func(t *testing.T, plugin string) (config.CombinedSettings, OutputPair) {
+		t.Helper()
+		var p config.Plugin
+		if err := json.Unmarshal([]byte(plugin), &p); err != nil {
+			t.Fatal(err)
+		}
+		c := combo
+		c.Global.Plugins = []config.Plugin{p}
+		pp := pair
+		pp.Gen = config.SQLGen{}
+		pp.Plugin = &config.Codegen{Plugin: p.Name, Out: "gen"}
+		return c, pp
+	}
+	local := fmt.Sprintf(`{"name": "greeter", "env": ["GREETING"], "wasm": {"url": "file://%s"}}`, filepath.ToSlash(module))
+	key := func(t *testing.T, plugin string) string {
+		t.Helper()
+		c, pp := withPlugin(t, plugin)
+		k, ok := gc.outputKey(c, pp, sqlcopts.Parser{})
+		if !ok {
+			t.Fatal("output pair isn't cacheable")
+		}
+		return k
+	}
+
+	t.Setenv("GREETING", "hello")
+	base := key(t, local)
+	gc.storeOutput(base, &generatedFiles{Out: "gen", Files: map[string]string{"hello.txt": "hello"}})
+
+	t.Run("Rebuilt", func(t *testing.T) {
+		writeCacheFile(t, dir, "plugin.wasm", "\x00asm v2")
+		defer writeCacheFile(t, dir, "plugin.wasm", "\x00asm v1")
+		k := key(t, local)
+		if k == base {
+			t.Fatal("rebuilding the module didn't change the key")
+		}
+		if _, ok := gc.loadOutput(k); ok {
+			t.Error("the output of the old module was loaded")
+		}
+	})
+
+	t.Run("Env", func(t *testing.T) {
+		t.Setenv("GREETING", "bonjour")
+		if key(t, local) == base {
+			t.Error("the value of a plugin env variable didn't change the key")
+		}
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		c, pp := withPlugin(t, `{"name": "greeter", "wasm": {"url": "file:///missing/plugin.wasm"}}`)
+		if _, ok := gc.outputKey(c, pp, sqlcopts.Parser{}); ok {
+			t.Error("a missing module was cacheable")
+		}
+	})
+
+	t.Run("Remote", func(t *testing.T) {
+		c, pp := withPlugin(t, `{"name": "greeter", "wasm": {"url": "https://example.com/plugin.wasm"}}`)
+		if _, ok := gc.outputKey(c, pp, sqlcopts.Parser{}); ok {
+			t.Error("a remote module without a sha256 was cacheable")
+		}
+		pinned := `{"name": "greeter", "wasm": {"url": "https://example.com/plugin.wasm", "sha256": "%s"}}`
+		if key(t, fmt.Sprintf(pinned, "aaaa")) == key(t, fmt.Sprintf(pinned, "bbbb")) {
+			t.Error("the sha256 of a pinned module didn't change the key")
+		}
+	})
+
+	if key(t, local) != base {
+		t.Error("restoring the module and env didn't restore the key")
+	}
+}
+
+// generateGo runs the Go codegen on a compiler result
+func generateGo(t *testing.T, combo config.CombinedSettings, pair OutputPair, result *compiler.Result) map[string]string {
+	t.Helper()
+	_, resp, err := codegen(context.Background(), combo, pair, result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	for _, file := range resp.Files {
+		files[file.Name] = string(file.Contents)
+	}
+	return files
+}
+
+func TestGenerateCacheCompile(t *testing.T) {
+	ctx := context.Background()
+	dir, pair, combo := cachePackage(t)
+	gc := testGenerateCache(t)
+
+	full, err := compile(ctx, pair.SQL, combo, sqlcopts.Parser{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := generateGo(t, combo, pair, full)
+
+	// The first run compiles everything and stores the queries of each file
+	first, err := gc.compile(ctx, pair.SQL, combo, sqlcopts.Parser{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, generateGo(t, combo, pair, first)); diff != "" {
+		t.Errorf("first run output mismatch:\n%s", diff)
+	}
+	_, keys, err := gc.queryKeys(pair.SQL, combo, sqlcopts.Parser{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range keys {
+		if _, err := os.Stat(filepath.Join(gc.dir, key+".json")); err != nil {
+			t.Errorf("queries weren't stored: %s", err)
+		}
+	}
+
+	// Stored queries generate the same code as freshly compiled ones
+	t.Run("RoundTrip", func(t *testing.T) {
+		cached, err := gc.compile(ctx, pair.SQL, combo, sqlcopts.Parser{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, generateGo(t, combo, pair, cached)); diff != "" {
+			t.Errorf("cached output mismatch:\n%s", diff)
+		}
+	})
+
+	// Stored records are read instead of compiling the file again
+	t.Run("Hit", func(t *testing.T) {
+		var queries []*compiler.Query
+		if !gc.load(keys[1], &queries) || len(queries) != 1 {
+			t.Fatalf("stored queries of books.sql weren't found")
+		}
+		queries[0].Metadata.Name = "CreateWriter"
+		gc.store(keys[1], queries)
+		defer func() {
+			queries[0].Metadata.Name = "CreateAuthor"
+			gc.store(keys[1], queries)
+		}()
+
+		cached, err := gc.compile(ctx, pair.SQL, combo, sqlcopts.Parser{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, q := range cached.Queries {
+			names = append(names, q.Metadata.Name)
+		}
+		if diff := cmp.Diff([]string{"GetAuthor", "ListAuthors", "CreateWriter"}, names); diff != "" {
+			t.Errorf("query names mismatch:\n%s", diff)
+		}
+	})
+
+	// Only the changed file is compiled again, and its key changes
+	t.Run("Invalidate", func(t *testing.T) {
+		writeCacheFile(t, dir, "books.sql", cacheBooks+"\n-- name: DeleteAuthor :exec\nDELETE FROM authors WHERE id = $1;\n")
+		_, changed, err := gc.queryKeys(pair.SQL, combo, sqlcopts.Parser{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if changed[0] != keys[0] {
+			t.Error("unchanged query file got a new key")
+		}
+		if changed[1] == keys[1] {
+			t.Error("changed query file kept its key")
+		}
+
+		full, err := compile(ctx, pair.SQL, combo, sqlcopts.Parser{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		partial, err := gc.compile(ctx, pair.SQL, combo, sqlcopts.Parser{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(generateGo(t, combo, pair, full), generateGo(t, combo, pair, partial)); diff != "" {
+			t.Errorf("partial compile output mismatch:\n%s", diff)
+		}
+
+		// A schema change invalidates every query file
+		writeCacheFile(t, dir, "schema.sql", cacheSchema+"\nCREATE TABLE books (id BIGSERIAL PRIMARY KEY);\n")
+		schema, _, err := gc.queryKeys(pair.SQL, combo, sqlcopts.Parser{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range schema {
+			if schema[i] == changed[i] {
+				t.Errorf("schema change didn't change the key of %s", pair.SQL.Queries[i])
+			}
+		}
+	})
+}
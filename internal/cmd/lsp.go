@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/lsp"
+)
+
+var lspCmd = &cobra.Command{
+	Use:   "lsp",
+	Short: "Run a language server for schema and query files over stdio",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		stderr := cmd.ErrOrStderr()
+		dir, name := getConfigPath(stderr, cmd.Flag("file"))
+		opts := &Options{
+			Env:    ParseEnv(cmd),
+			Stderr: io.Discard,
+		}
+		srv := &lsp.Server{
+			Dir: dir,
+			Config: func() (*config.Config, error) {
+				_, conf, err := opts.ReadConfig(dir, name)
+				if err != nil {
+					return nil, err
+				}
+				if err := config.Validate(conf); err != nil {
+					return nil, err
+				}
+				return conf, nil
+			},
+		}
+		return srv.Serve(cmd.Context(), cmd.InOrStdin(), cmd.OutOrStdout())
+	},
+}
@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/trace"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/migrations"
+	"github.com/sqlc-dev/sqlc/internal/migrations/schemadiff"
+	"github.com/sqlc-dev/sqlc/internal/multierr"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+func init() {
+	migrateDiffCmd.Flags().String("queryset", "", "name of the queryset to use")
+	migrateDiffCmd.Flags().String("from", "HEAD", "git revision or schema directory to migrate from")
+	migrateDiffCmd.Flags().String("to", "", "git revision or schema directory to migrate to (default: working tree)")
+	migrateDiffCmd.Flags().String("dir", "", "migration directory to write the migration to (default: print to stdout)")
+	migrateDiffCmd.Flags().String("name", "sqlc_diff", "name of the new migration")
+	migrateCmd.AddCommand(migrateDiffCmd)
+}
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Work with database migrations",
+}
+
+var migrateDiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Write a migration for the schema changes between two revisions",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer trace.StartRegion(cmd.Context(), "migrate diff").End()
+		stderr := cmd.ErrOrStderr()
+		dir, filename := getConfigPath(stderr, cmd.Flag("file"))
+		var opts MigrateDiffOptions
+		var err error
+		if opts.QuerySet, err = cmd.Flags().GetString("queryset"); err != nil {
+			return err
+		}
+		if opts.From, err = cmd.Flags().GetString("from"); err != nil {
+			return err
+		}
+		if opts.To, err = cmd.Flags().GetString("to"); err != nil {
+			return err
+		}
+		if opts.Dir, err = cmd.Flags().GetString("dir"); err != nil {
+			return err
+		}
+		if opts.Name, err = cmd.Flags().GetString("name"); err != nil {
+			return err
+		}
+		err = MigrateDiff(cmd.Context(), dir, filename, &opts, &Options{
+			Env:    ParseEnv(cmd),
+			Stderr: stderr,
+		}, cmd.OutOrStdout())
+		if err != nil {
+			fmt.Fprintln(stderr, err.Error())
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+type MigrateDiffOptions struct {
+	QuerySet string
+	// A git revision or a directory. An empty string is the schema in the
+	// working tree.
+	From string
+	To   string
+	// The migration directory. When empty, the migration is written to stdout.
+	Dir  string
+	Name string
+}
+
+func MigrateDiff(ctx context.Context, dir, filename string, m *MigrateDiffOptions, o *Options, stdout io.Writer) error {
+	_, conf, err := o.ReadConfig(dir, filename)
+	if err != nil {
+		return err
+	}
+	var queryset *config.SQL
+	for _, sql := range conf.SQL {
+		sql := sql
+		if m.QuerySet != "" && sql.Name != m.QuerySet {
+			continue
+		}
+		if queryset != nil {
+			return fmt.Errorf("multiple querysets configured, use --queryset to pick one")
+		}
+		queryset = &sql
+	}
+	if queryset == nil && m.QuerySet != "" {
+		return fmt.Errorf("no queryset found with name %q", m.QuerySet)
+	}
+	if queryset == nil {
+		return fmt.Errorf("no querysets configured")
+	}
+	combo := config.Combine(*conf, *queryset)
+
+	from, err := loadSchemaAt(ctx, dir, m.From, *queryset, combo, o.Stderr)
+	if err != nil {
+		return err
+	}
+	to, err := loadSchemaAt(ctx, dir, m.To, *queryset, combo, o.Stderr)
+	if err != nil {
+		return err
+	}
+
+	up := schemadiff.Diff(queryset.Engine, from, to)
+	if len(up) == 0 {
+		fmt.Fprintln(o.Stderr, "no schema changes")
+		return nil
+	}
+	if m.Dir == "" {
+		fmt.Fprint(stdout, migrations.Join(up))
+		return nil
+	}
+	down := schemadiff.Diff(queryset.Engine, to, from)
+
+	existing, err := sqlpath.Glob([]string{m.Dir})
+	if err != nil {
+		return err
+	}
+	tool, err := migrations.Detect(m.Dir, existing)
+	if err != nil {
+		return err
+	}
+	for _, file := range migrations.NewFiles(tool, existing, m.Name, time.Now(), up, down) {
+		path := filepath.Join(m.Dir, file.Name)
+		if err := os.WriteFile(path, []byte(file.Contents), 0644); err != nil {
+			return err
+		}
+		fmt.Fprintln(stdout, path)
+	}
+	if tool == migrations.ToolAtlas {
+		fmt.Fprintln(o.Stderr, "atlas.sum is out of date, run `atlas migrate hash` to update it")
+	}
+	return nil
+}
+
+// loadSchemaAt builds the schema of a queryset at a git revision, from a
+// schema directory, or from the working tree when rev is empty.
+func loadSchemaAt(ctx context.Context, dir, rev string, sql config.SQL, combo config.CombinedSettings, stderr io.Writer) (*schemadiff.Schema, error) {
+	root := dir
+	var schema []string
+	// A directory is resolved relative to the config file, like schema paths
+	revDir := rev
+	if !filepath.IsAbs(revDir) {
+		revDir = filepath.Join(dir, rev)
+	}
+	if f, err := os.Stat(revDir); rev != "" && err == nil && f.IsDir() {
+		schema = []string{revDir}
+	} else {
+		if rev != "" {
+			tmp, err := os.MkdirTemp("", "sqlc-migrate-")
+			if err != nil {
+				return nil, err
+			}
+			defer os.RemoveAll(tmp)
+			if err := checkoutSchema(ctx, dir, rev, tmp); err != nil {
+				return nil, err
+			}
+			root = tmp
+		}
+		for _, s := range sql.Schema {
+			path := filepath.Join(root, s)
+			// The schema may not exist yet at older revisions
+			if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) && !strings.ContainsAny(s, "*?[]") {
+				continue
+			}
+			schema = append(schema, path)
+		}
+	}
+	s, err := schemadiff.Load(ctx, sql, combo, schema)
+	if err != nil {
+		if parserErr, ok := err.(*multierr.Error); ok {
+			for _, fileErr := range parserErr.Errs() {
+				printFileErr(stderr, root, fileErr)
+			}
+			return nil, fmt.Errorf("error parsing schema at %s", revName(rev))
+		}
+		return nil, fmt.Errorf("error parsing schema at %s: %w", revName(rev), err)
+	}
+	return s, nil
+}
+
+func revName(rev string) string {
+	if rev == "" {
+		return "working tree"
+	}
+	return rev
+}
+
+// checkoutSchema writes the SQL files under dir at a git revision into out,
+// keeping their paths relative to dir. atlas.sum files are included so that
+// migrations are ordered the same way they would be in the working tree.
+func checkoutSchema(ctx context.Context, dir, rev, out string) error {
+	git := func(args ...string) ([]byte, error) {
+		cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+		var stderr strings.Builder
+		cmd.Stderr = &stderr
+		blob, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+		}
+		return blob, nil
+	}
+	listing, err := git("ls-tree", "-r", "--name-only", rev)
+	if err != nil {
+		return err
+	}
+	for _, name := range strings.Split(strings.TrimSpace(string(listing)), "\n") {
+		if !strings.HasSuffix(name, ".sql") && filepath.Base(name) != "atlas.sum" {
+			continue
+		}
+		blob, err := git("show", rev+":./"+name)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(out, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, blob, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"path/filepath"
 
 	"github.com/sqlc-dev/sqlc/internal/config"
 )
@@ -9,17 +12,35 @@ import (
 type Options struct {
 	Env    Env
 	Stderr io.Writer
+	// Stdout receives diagnostics in the machine-readable formats, and reports
+	Stdout io.Writer
 	// TODO: Move these to a command-specific struct
 	Tags    []string
 	Against string
+	// Vet only
+	FailOn         string
+	Baseline       string
+	UpdateBaseline bool
+	TopCost        int
 
 	// Testing only
 	MutateConfig func(*config.Config)
+
+	// Collects diagnostics unless they're printed as text
+	diags *diagnostics
 }
 
 func (o *Options) ReadConfig(dir, filename string) (string, *config.Config, error) {
-	path, conf, err := readConfig(o.Stderr, dir, filename)
+	stderr := o.Stderr
+	if o.diags != nil {
+		stderr = io.Discard
+	}
+	path, conf, err := readConfig(stderr, dir, filename)
 	if err != nil {
+		var cerr *configError
+		if o.diags != nil && errors.As(err, &cerr) {
+			o.diags.add(Diagnostic{File: cerr.path, Message: cerr.msg})
+		}
 		return path, conf, err
 	}
 	if o.MutateConfig != nil {
@@ -27,3 +48,32 @@ func (o *Options) ReadConfig(dir, filename string) (string, *config.Config, erro
 	}
 	return path, conf, nil
 }
+
+// validateConfig checks the configuration read from path, and the features
+// it uses
+func (o *Options) validateConfig(path string, conf *config.Config) error {
+	err := config.Validate(conf)
+	if err == nil {
+		err = o.Env.Validate(conf)
+	}
+	if err == nil {
+		return nil
+	}
+	msg := fmt.Sprintf("error validating %s: %s", filepath.Base(path), err)
+	if o.diags != nil {
+		o.diags.add(Diagnostic{File: path, Message: msg})
+	} else {
+		fmt.Fprintln(o.Stderr, msg)
+	}
+	return err
+}
+
+// WriteDiagnostics writes the diagnostics collected while running a command
+// in the machine-readable format the options were created for
+func (o *Options) WriteDiagnostics() error {
+	w := o.Stdout
+	if w == nil {
+		w = o.Stderr
+	}
+	return o.diags.write(w)
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/opts"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+// parseCache shares compiler results between the output pairs of a single
+// run. Pairs with the same engine, settings, schema and queries are compiled
+// once, no matter how many codegen targets they feed. With a generateCache,
+// queries compiled by earlier runs are reused as well.
+//
+// A result is shared by every pair with the same key, and those pairs run
+// concurrently. Results must be treated as read-only: processors build their
+// own plugin requests from them instead of editing the queries or catalog.
+type parseCache struct {
+	mu      sync.Mutex
+	entries map[string]*parseEntry
+	persist *generateCache
+}
+
+type parseEntry struct {
+	done   chan struct{}
+	result *compiler.Result
+	err    error
+}
+
+func newParseCache() *parseCache {
+	return &parseCache{entries: map[string]*parseEntry{}}
+}
+
+// compile returns the memoized result for the given settings, compiling them
+// on first use. A nil cache compiles every time.
+func (pc *parseCache) compile(ctx context.Context, sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser) (*compiler.Result, error) {
+	if pc == nil {
+		return compile(ctx, sql, combo, parserOpts)
+	}
+	key, err := parseKey(sql, combo, parserOpts)
+	if err != nil {
+		// Let the compiler report unreadable files
+		return compile(ctx, sql, combo, parserOpts)
+	}
+
+	pc.mu.Lock()
+	entry, found := pc.entries[key]
+	if !found {
+		entry = &parseEntry{done: make(chan struct{})}
+		pc.entries[key] = entry
+	}
+	pc.mu.Unlock()
+
+	if found {
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		return entry.result, entry.err
+	}
+
+	entry.result, entry.err = pc.persist.compile(ctx, sql, combo, parserOpts)
+	close(entry.done)
+	return entry.result, entry.err
+}
+
+// parseKey identifies everything a compiler result depends on: the engine,
+// the settings that change how queries are checked, the database servers
+// and parser options used to compile them, and the paths and contents of the
+// schema and query files. Code generation options aren't part of the key, so
+// pairs that only differ in their output share a result.
+func parseKey(sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser) (string, error) {
+	h := sha256.New()
+	settings := struct {
+		Engine               config.Engine
+		Schema               []string
+		Queries              []string
+		Database             *config.Database
+		StrictFunctionChecks bool
+		StrictOrderBy        *bool
+		Analyzer             config.Analyzer
+		Servers              []config.Server
+		Cloud                config.Cloud
+		Parser               opts.Parser
+	}{
+		Engine:               sql.Engine,
+		Schema:               sql.Schema,
+		Queries:              sql.Queries,
+		Database:             sql.Database,
+		StrictFunctionChecks: sql.StrictFunctionChecks,
+		StrictOrderBy:        sql.StrictOrderBy,
+		Analyzer:             sql.Analyzer,
+		Servers:              combo.Global.Servers,
+		Cloud:                combo.Global.Cloud,
+		Parser:               parserOpts,
+	}
+	if err := json.NewEncoder(h).Encode(settings); err != nil {
+		return "", err
+	}
+	for _, paths := range [][]string{sql.Schema, sql.Queries} {
+		files, err := sqlpath.Glob(paths)
+		if err != nil {
+			return "", err
+		}
+		for _, file := range files {
+			blob, err := os.ReadFile(file)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(h, "%s %x\n", file, sha256.Sum256(blob))
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/opts"
+)
+
+func TestParseKey(t *testing.T) {
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.sql")
+	query := filepath.Join(dir, "query.sql")
+	if err := os.WriteFile(schema, []byte("CREATE TABLE authors (id int);"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(query, []byte("-- name: ListAuthors :many\nSELECT * FROM authors;"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	sql := config.SQL{
+		Engine:  config.EnginePostgreSQL,
+		Schema:  []string{schema},
+		Queries: []string{query},
+	}
+	combo := config.Combine(config.Config{}, sql)
+
+	key := func(t *testing.T, sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser) string {
+		t.Helper()
+		k, err := parseKey(sql, combo, parserOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return k
+	}
+	base := key(t, sql, combo, opts.Parser{})
+
+	codegen := sql
+	codegen.Gen.JSON = &config.SQLJSON{Out: "gen"}
+	if k := key(t, codegen, config.Combine(config.Config{}, codegen), opts.Parser{}); k != base {
+		t.Errorf("codegen options changed the key")
+	}
+
+	servers := config.Combine(config.Config{Servers: []config.Server{{Name: "pg", Engine: config.EnginePostgreSQL, URI: "postgres://localhost"}}}, sql)
+	if k := key(t, sql, servers, opts.Parser{}); k == base {
+		t.Errorf("database servers didn't change the key")
+	}
+
+	dump := opts.Parser{Debug: opts.Debug{DumpAST: true}}
+	if k := key(t, sql, combo, dump); k == base {
+		t.Errorf("parser options didn't change the key")
+	}
+
+	if err := os.WriteFile(query, []byte("-- name: ListAuthors :many\nSELECT id FROM authors;"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if k := key(t, sql, combo, opts.Parser{}); k == base {
+		t.Errorf("query contents didn't change the key")
+	}
+}
@@ -29,39 +29,54 @@ type ResultProcessor interface {
 	ProcessResult(context.Context, config.CombinedSettings, OutputPair, *compiler.Result) error
 }
 
-func Process(ctx context.Context, rp ResultProcessor, dir, filename string, o *Options) error {
-	e := o.Env
-	stderr := o.Stderr
+// A cachingProcessor keeps its results between runs. Output pairs found in
+// the cache are restored instead of compiled and processed.
+type cachingProcessor interface {
+	ResultProcessor
+	cache() *generateCache
+	restore(*generatedFiles) error
+	process(context.Context, config.CombinedSettings, OutputPair, *compiler.Result) (*generatedFiles, error)
+}
 
+func Process(ctx context.Context, rp ResultProcessor, dir, filename string, o *Options) error {
 	configPath, conf, err := o.ReadConfig(dir, filename)
 	if err != nil {
 		return err
 	}
 
-	base := filepath.Base(configPath)
-	if err := config.Validate(conf); err != nil {
-		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
-		return err
-	}
-
-	if err := e.Validate(conf); err != nil {
-		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
+	if err := o.validateConfig(configPath, conf); err != nil {
 		return err
 	}
 
 	return processQuerySets(ctx, rp, conf, dir, o)
 }
 
+// pairsError is returned by processQuerySets when output pairs failed. The
+// output of the other pairs was processed as usual.
+type pairsError struct {
+	// Indexes of the failed pairs, into the pairs of the processor
+	failed []int
+}
+
+func (e *pairsError) Error() string {
+	return "errored"
+}
+
 func processQuerySets(ctx context.Context, rp ResultProcessor, conf *config.Config, dir string, o *Options) error {
 	stderr := o.Stderr
 
-	errored := false
-
 	pairs := rp.Pairs(ctx, conf)
+	// Each pair only sets its own entry, so no lock is needed
+	errored := make([]bool, len(pairs))
 	grp, gctx := errgroup.WithContext(ctx)
 	grp.SetLimit(runtime.GOMAXPROCS(0))
 
 	stderrs := make([]bytes.Buffer, len(pairs))
+	parses := newParseCache()
+	cp, caching := rp.(cachingProcessor)
+	if caching {
+		parses.persist = cp.cache()
+	}
 
 	for i, pair := range pairs {
 		sql := pair
@@ -104,16 +119,41 @@ func processQuerySets(ctx context.Context, rp ResultProcessor, conf *config.Conf
 			packageRegion := trace.StartRegion(gctx, "package")
 			trace.Logf(gctx, "", "name=%s dir=%s plugin=%s", name, dir, lang)
 
-			result, failed := parse(gctx, name, dir, sql.SQL, combo, parseOpts, errout)
+			var key string
+			var keyed bool
+			if caching {
+				key, keyed = cp.cache().outputKey(combo, sql, parseOpts)
+			}
+			if keyed {
+				if files, ok := cp.cache().loadOutput(key); ok {
+					if err := cp.restore(files); err != nil {
+						codegenErr(o.diags, errout, name, err)
+						errored[i] = true
+					}
+					packageRegion.End()
+					return nil
+				}
+			}
+
+			result, failed := parse(gctx, parses, name, dir, sql.SQL, combo, parseOpts, o.diags, errout)
 			if failed {
 				packageRegion.End()
-				errored = true
+				errored[i] = true
 				return nil
 			}
-			if err := rp.ProcessResult(gctx, combo, sql, result); err != nil {
-				fmt.Fprintf(errout, "# package %s\n", name)
-				fmt.Fprintf(errout, "error generating code: %s\n", err)
-				errored = true
+			var err error
+			if caching {
+				var files *generatedFiles
+				files, err = cp.process(gctx, combo, sql, result)
+				if err == nil && keyed {
+					cp.cache().storeOutput(key, files)
+				}
+			} else {
+				err = rp.ProcessResult(gctx, combo, sql, result)
+			}
+			if err != nil {
+				codegenErr(o.diags, errout, name, err)
+				errored[i] = true
 			}
 			packageRegion.End()
 			return nil
@@ -122,13 +162,28 @@ func processQuerySets(ctx context.Context, rp ResultProcessor, conf *config.Conf
 	if err := grp.Wait(); err != nil {
 		return err
 	}
-	if errored {
+	perr := &pairsError{}
+	for i := range errored {
+		if errored[i] {
+			perr.failed = append(perr.failed, i)
+		}
+	}
+	if len(perr.failed) > 0 {
 		for i, _ := range stderrs {
 			if _, err := io.Copy(stderr, &stderrs[i]); err != nil {
 				return err
 			}
 		}
-		return fmt.Errorf("errored")
+		return perr
 	}
 	return nil
 }
+
+func codegenErr(diags *diagnostics, stderr io.Writer, name string, err error) {
+	if diags != nil {
+		diags.add(Diagnostic{Message: fmt.Sprintf("error generating code: %s", err), Package: name})
+		return
+	}
+	fmt.Fprintf(stderr, "# package %s\n", name)
+	fmt.Fprintf(stderr, "error generating code: %s\n", err)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/config/convert"
 	"github.com/sqlc-dev/sqlc/internal/info"
 	"github.com/sqlc-dev/sqlc/internal/plugin"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
 )
 
@@ -64,8 +65,24 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 	for _, s := range c.Schemas {
 		var enums []*plugin.Enum
 		var cts []*plugin.CompositeType
+		var domains []*plugin.Domain
 		for _, typ := range s.Types {
 			switch typ := typ.(type) {
+			case *catalog.Domain:
+				domains = append(domains, &plugin.Domain{
+					Name:    typ.Name,
+					Comment: typ.Comment,
+					BaseType: &plugin.Identifier{
+						Catalog: typ.BaseType.Catalog,
+						Schema:  typ.BaseType.Schema,
+						Name:    typ.BaseType.Name,
+					},
+					IsArray:   typ.IsArray,
+					ArrayDims: int32(typ.ArrayDims),
+					NotNull:   typ.NotNull,
+					Checks:    typ.Checks,
+					Default:   typ.Default,
+				})
 			case *catalog.Enum:
 				enums = append(enums, &plugin.Enum{
 					Name:    typ.Name,
@@ -73,39 +90,59 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 					Vals:    typ.Vals,
 				})
 			case *catalog.CompositeType:
+				var columns []*plugin.Column
+				for _, col := range typ.Columns {
+					columns = append(columns, resolveDomain(c, &plugin.Column{
+						Name: col.Name,
+						Type: &plugin.Identifier{
+							Catalog: col.Type.Catalog,
+							Schema:  col.Type.Schema,
+							Name:    col.Type.Name,
+						},
+						IsArray:   col.IsArray,
+						ArrayDims: int32(col.ArrayDims),
+						Length:    -1,
+					}))
+				}
 				cts = append(cts, &plugin.CompositeType{
 					Name:    typ.Name,
 					Comment: typ.Comment,
+					Columns: columns,
 				})
 			}
 		}
 		var tables []*plugin.Table
 		for _, t := range s.Tables {
 			var columns []*plugin.Column
-			for _, c := range t.Columns {
+			for _, col := range t.Columns {
+				// Hidden columns aren't part of a row of the table, so they
+				// don't belong in the models
+				if col.IsHidden {
+					continue
+				}
 				l := -1
-				if c.Length != nil {
-					l = *c.Length
+				if col.Length != nil {
+					l = *col.Length
 				}
-				columns = append(columns, &plugin.Column{
-					Name: c.Name,
+				columns = append(columns, resolveDomain(c, &plugin.Column{
+					Name: col.Name,
 					Type: &plugin.Identifier{
-						Catalog: c.Type.Catalog,
-						Schema:  c.Type.Schema,
-						Name:    c.Type.Name,
+						Catalog: col.Type.Catalog,
+						Schema:  col.Type.Schema,
+						Name:    col.Type.Name,
 					},
-					Comment:   c.Comment,
-					NotNull:   c.IsNotNull,
-					Unsigned:  c.IsUnsigned,
-					IsArray:   c.IsArray,
-					ArrayDims: int32(c.ArrayDims),
+					Comment:   col.Comment,
+					NotNull:   col.IsNotNull,
+					Unsigned:  col.IsUnsigned,
+					IsArray:   col.IsArray,
+					ArrayDims: int32(col.ArrayDims),
 					Length:    int32(l),
 					Table: &plugin.Identifier{
 						Catalog: t.Rel.Catalog,
 						Schema:  t.Rel.Schema,
 						Name:    t.Rel.Name,
 					},
-				})
+				}))
 			}
 			tables = append(tables, &plugin.Table{
 				Rel: &plugin.Identifier{
@@ -113,8 +150,10 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 					Schema:  t.Rel.Schema,
 					Name:    t.Rel.Name,
 				},
-				Columns: columns,
-				Comment: t.Comment,
+				Columns:     columns,
+				Comment:     t.Comment,
+				Indexes:     pluginIndexes(t.Indexes),
+				ForeignKeys: pluginForeignKeys(t.ForeignKeys),
 			})
 		}
 		schemas = append(schemas, &plugin.Schema{
@@ -123,6 +162,7 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 			Tables:         tables,
 			Enums:          enums,
 			CompositeTypes: cts,
+			Domains:        domains,
 		})
 	}
 	return &plugin.Catalog{
@@ -133,6 +173,60 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 	}
 }
 
+// resolveDomain replaces a domain by its base type, like the compiler does
+// for the columns of queries
+func resolveDomain(c *catalog.Catalog, col *plugin.Column) *plugin.Column {
+	d := c.GetDomain(&ast.TypeName{
+		Catalog: col.Type.Catalog,
+		Schema:  col.Type.Schema,
+		Name:    col.Type.Name,
+	})
+	if d == nil {
+		return col
+	}
+	col.Domain = col.Type
+	col.Type = &plugin.Identifier{
+		Catalog: d.BaseType.Catalog,
+		Schema:  d.BaseType.Schema,
+		Name:    d.BaseType.Name,
+	}
+	if d.IsArray {
+		col.IsArray = true
+		col.ArrayDims += int32(d.ArrayDims)
+	}
+	return col
+}
+
+func pluginIndexes(indexes []*catalog.Index) []*plugin.Index {
+	var out []*plugin.Index
+	for _, idx := range indexes {
+		out = append(out, &plugin.Index{
+			Name:    idx.Name,
+			Columns: idx.Columns,
+			Unique:  idx.Unique,
+			Primary: idx.Primary,
+		})
+	}
+	return out
+}
+
+func pluginForeignKeys(fks []*catalog.ForeignKey) []*plugin.ForeignKey {
+	var out []*plugin.ForeignKey
+	for _, fk := range fks {
+		out = append(out, &plugin.ForeignKey{
+			Name:    fk.Name,
+			Columns: fk.Columns,
+			RefTable: &plugin.Identifier{
+				Catalog: fk.RefTable.Catalog,
+				Schema:  fk.RefTable.Schema,
+				Name:    fk.RefTable.Name,
+			},
+			RefColumns: fk.RefColumns,
+		})
+	}
+	return out
+}
+
 func pluginQueries(r *compiler.Result) []*plugin.Query {
 	var out []*plugin.Query
 	for _, q := range r.Queries {
@@ -152,6 +246,14 @@ func pluginQueries(r *compiler.Result) []*plugin.Query {
 				Name:    q.InsertIntoTable.Name,
 			}
 		}
+		var pagination *plugin.Pagination
+		if q.Pagination != nil {
+			pagination = &plugin.Pagination{
+				Columns:       q.Pagination.Columns,
+				AfterParams:   q.Pagination.AfterParams,
+				PageSizeParam: q.Pagination.PageSizeParam,
+			}
+		}
 		out = append(out, &plugin.Query{
 			Name:            q.Metadata.Name,
 			Cmd:             q.Metadata.Cmd,
@@ -161,6 +263,7 @@ func pluginQueries(r *compiler.Result) []*plugin.Query {
 			Params:          params,
 			Filename:        q.Metadata.Filename,
 			InsertIntoTable: iit,
+			Pagination:      pagination,
 		})
 	}
 	return out
@@ -205,6 +308,14 @@ func pluginQueryColumn(c *compiler.Column) *plugin.Column {
 		}
 	}
 
+	if c.Domain != nil {
+		out.Domain = &plugin.Identifier{
+			Catalog: c.Domain.Catalog,
+			Schema:  c.Domain.Schema,
+			Name:    c.Domain.Name,
+		}
+	}
+
 	if c.EmbedTable != nil {
 		out.EmbedTable = &plugin.Identifier{
 			Catalog: c.EmbedTable.Catalog,
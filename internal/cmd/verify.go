@@ -97,6 +97,10 @@ func Verify(ctx context.Context, dir, filename string, opts *Options) error {
 			if err != nil {
 				return err
 			}
+			files, err = migrations.Order(files)
+			if err != nil {
+				return err
+			}
 			for _, schema := range files {
 				contents, err := os.ReadFile(schema)
 				if err != nil {
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -21,9 +22,13 @@ import (
 	"github.com/google/cel-go/ext"
 	"github.com/jackc/pgx/v5"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/sqlc-dev/sqlc/internal/compiler"
 	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/config/convert"
 	"github.com/sqlc-dev/sqlc/internal/dbmanager"
 	"github.com/sqlc-dev/sqlc/internal/debug"
 	"github.com/sqlc-dev/sqlc/internal/migrations"
@@ -31,6 +36,7 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/plugin"
 	"github.com/sqlc-dev/sqlc/internal/quickdb"
 	"github.com/sqlc-dev/sqlc/internal/shfmt"
+	"github.com/sqlc-dev/sqlc/internal/source"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
 	"github.com/sqlc-dev/sqlc/internal/vet"
 )
@@ -40,18 +46,31 @@ var ErrFailedChecks = errors.New("failed checks")
 var pjson = protojson.UnmarshalOptions{AllowPartial: true, DiscardUnknown: true}
 
 func NewCmdVet() *cobra.Command {
-	return &cobra.Command{
+	vetCmd := &cobra.Command{
 		Use:   "vet",
 		Short: "Vet examines queries",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			defer trace.StartRegion(cmd.Context(), "vet").End()
 			stderr := cmd.ErrOrStderr()
-			opts := &Options{
-				Env:    ParseEnv(cmd),
-				Stderr: stderr,
+			opts, err := diagnosticOptions(cmd)
+			if err != nil {
+				fmt.Fprintf(stderr, "error: %s\n", err)
+				os.Exit(1)
+			}
+			opts.FailOn, _ = cmd.Flags().GetString("fail-on")
+			opts.Baseline, _ = cmd.Flags().GetString("baseline")
+			opts.UpdateBaseline, _ = cmd.Flags().GetBool("update-baseline")
+			opts.TopCost, _ = cmd.Flags().GetInt("top-cost")
+			if format, _ := cmd.Flags().GetString("format"); opts.TopCost > 0 && format != formatText {
+				fmt.Fprintf(stderr, "error: --top-cost can't be used with --format %s\n", format)
+				os.Exit(1)
 			}
 			dir, name := getConfigPath(stderr, cmd.Flag("file"))
-			if err := Vet(cmd.Context(), dir, name, opts); err != nil {
+			err = Vet(cmd.Context(), dir, name, opts)
+			if werr := opts.WriteDiagnostics(); werr != nil {
+				fmt.Fprintf(stderr, "error writing diagnostics: %s\n", werr)
+			}
+			if err != nil {
 				if !errors.Is(err, ErrFailedChecks) {
 					fmt.Fprintf(stderr, "%s\n", err)
 				}
@@ -60,24 +79,22 @@ func NewCmdVet() *cobra.Command {
 			return nil
 		},
 	}
+	vetCmd.Flags().String("format", formatText, "format of the reported problems: text, json, sarif or github")
+	vetCmd.Flags().String("fail-on", severityError, "lowest severity that fails the checks: error, warning or info")
+	vetCmd.Flags().String("baseline", "", "path to a file of known problems that don't fail the checks")
+	vetCmd.Flags().Bool("update-baseline", false, "write every problem found to the baseline file instead of reporting it")
+	vetCmd.Flags().Int("top-cost", 0, "print the N queries with the highest estimated cost in each PostgreSQL package")
+	return vetCmd
 }
 
 func Vet(ctx context.Context, dir, filename string, opts *Options) error {
 	e := opts.Env
 	stderr := opts.Stderr
-	configPath, conf, err := readConfig(stderr, dir, filename)
+	configPath, conf, err := opts.ReadConfig(dir, filename)
 	if err != nil {
 		return err
 	}
-
-	base := filepath.Base(configPath)
-	if err := config.Validate(conf); err != nil {
-		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
-		return err
-	}
-
-	if err := e.Validate(conf); err != nil {
-		fmt.Fprintf(stderr, "error validating %s: %s\n", base, err)
+	if err := opts.validateConfig(configPath, conf); err != nil {
 		return err
 	}
 
@@ -87,8 +104,10 @@ func Vet(ctx context.Context, dir, filename string, opts *Options) error {
 		cel.Types(
 			&vet.Config{},
 			&vet.Query{},
+			&vet.Catalog{},
 			&vet.PostgreSQL{},
 			&vet.MySQL{},
+			&vet.SQLite{},
 		),
 		cel.Variable("query",
 			cel.ObjectType("vet.Query"),
@@ -96,27 +115,66 @@ func Vet(ctx context.Context, dir, filename string, opts *Options) error {
 		cel.Variable("config",
 			cel.ObjectType("vet.Config"),
 		),
+		cel.Variable("catalog",
+			cel.ObjectType("vet.Catalog"),
+		),
 		cel.Variable("postgresql",
 			cel.ObjectType("vet.PostgreSQL"),
 		),
 		cel.Variable("mysql",
 			cel.ObjectType("vet.MySQL"),
 		),
+		cel.Variable("sqlite",
+			cel.ObjectType("vet.SQLite"),
+		),
 	)
 	if err != nil {
 		return fmt.Errorf("new CEL env error: %s", err)
 	}
 
 	rules := map[string]rule{
-		constants.QueryRuleDbPrepare: {NeedsPrepare: true},
+		constants.QueryRuleDbPrepare: {NeedsPrepare: true, Severity: severityError},
+		// Budgets are checked for every query with a @sqlc-budget comment
+		constants.QueryRuleBudget: {NeedsExplain: true, Severity: severityError},
+	}
+	for name, check := range builtinChecks {
+		rules[name] = rule{Check: check, Severity: severityError}
 	}
 
+	configured := map[string]bool{}
 	for _, c := range conf.Rules {
 		if c.Name == "" {
 			return fmt.Errorf("rules require a name")
 		}
-		if _, found := rules[c.Name]; found {
-			return fmt.Errorf("type-check error: a rule with the name '%s' already exists", c.Name)
+		severity, err := ruleSeverity(c)
+		if err != nil {
+			return err
+		}
+		if builtin, found := rules[c.Name]; found {
+			// Built-in rules can be listed to change their severity
+			if configured[c.Name] || c.Rule != "" || c.Plugin != "" {
+				return fmt.Errorf("type-check error: a rule with the name '%s' already exists", c.Name)
+			}
+			builtin.Severity = severity
+			rules[c.Name] = builtin
+			configured[c.Name] = true
+			continue
+		}
+		configured[c.Name] = true
+		if c.Plugin != "" {
+			if c.Rule != "" {
+				return fmt.Errorf("type-check error: %s can't have both a rule and a plugin", c.Name)
+			}
+			plug, err := findPlugin(*conf, c.Plugin)
+			if err != nil {
+				return fmt.Errorf("type-check error: %s: %s: %s", c.Name, err, c.Plugin)
+			}
+			opts, err := convert.YAMLtoJSON(c.Options)
+			if err != nil {
+				return fmt.Errorf("type-check error: %s: invalid plugin options: %s", c.Name, err)
+			}
+			rules[c.Name] = rule{Plugin: plug, Options: opts, Message: c.Msg, Severity: severity}
+			continue
 		}
 		if c.Rule == "" {
 			return fmt.Errorf("type-check error: %s is empty", c.Name)
@@ -129,24 +187,50 @@ func Vet(ctx context.Context, dir, filename string, opts *Options) error {
 		if err != nil {
 			return fmt.Errorf("program construction error: %s %s", c.Name, err)
 		}
-		rule := rule{Program: &prg, Message: c.Msg}
+		rule := rule{Program: &prg, Message: c.Msg, Severity: severity}
 
 		// TODO There's probably a nicer way to do this from the ast
 		// https://pkg.go.dev/github.com/google/cel-go/common/ast#AllMatcher
 		if strings.Contains(c.Rule, "postgresql.explain") ||
-			strings.Contains(c.Rule, "mysql.explain") {
+			strings.Contains(c.Rule, "mysql.explain") ||
+			strings.Contains(c.Rule, "sqlite.explain") {
 			rule.NeedsExplain = true
 		}
 
 		rules[c.Name] = rule
 	}
 
+	failOn := cmp.Or(opts.FailOn, severityError)
+	switch failOn {
+	case severityError, severityWarning, severityInfo:
+	default:
+		return fmt.Errorf("unknown --fail-on severity %q: must be error, warning or info", failOn)
+	}
+	var baseline *vetBaseline
+	switch {
+	case opts.UpdateBaseline && opts.Baseline == "":
+		return fmt.Errorf("--update-baseline requires --baseline")
+	case opts.UpdateBaseline:
+		baseline = &vetBaseline{}
+	case opts.Baseline != "":
+		baseline, err = readBaseline(opts.Baseline)
+		if err != nil {
+			return err
+		}
+	}
+
 	c := checker{
 		Rules:         rules,
 		Conf:          conf,
 		Dir:           dir,
 		Env:           env,
 		Stderr:        stderr,
+		Stdout:        cmp.Or[io.Writer](opts.Stdout, os.Stdout),
+		Diags:         opts.diags,
+		FailOn:        failOn,
+		Baseline:      baseline,
+		WriteBaseline: opts.UpdateBaseline,
+		TopCost:       opts.TopCost,
 		OnlyManagedDB: e.Debug.OnlyManagedDatabases,
 		Replacer:      shfmt.NewReplacer(nil),
 	}
@@ -154,7 +238,11 @@ func Vet(ctx context.Context, dir, filename string, opts *Options) error {
 	for _, sql := range conf.SQL {
 		if err := c.checkSQL(ctx, sql); err != nil {
 			if !errors.Is(err, ErrFailedChecks) {
-				fmt.Fprintf(stderr, "%s\n", err)
+				if c.Diags != nil {
+					c.Diags.add(Diagnostic{Message: err.Error()})
+				} else {
+					fmt.Fprintf(stderr, "%s\n", err)
+				}
 			}
 			errored = true
 		}
@@ -162,6 +250,12 @@ func Vet(ctx context.Context, dir, filename string, opts *Options) error {
 	if errored {
 		return ErrFailedChecks
 	}
+	if c.WriteBaseline {
+		if err := baseline.write(opts.Baseline); err != nil {
+			return fmt.Errorf("error writing baseline: %w", err)
+		}
+		fmt.Fprintf(stderr, "wrote %d findings to %s\n", len(baseline.Findings), opts.Baseline)
+	}
 	return nil
 }
 
@@ -374,8 +468,39 @@ func (me *mysqlExplainer) Explain(ctx context.Context, query string, args ...*pl
 type rule struct {
 	Program      *cel.Program
 	Message      string
+	Severity     string
 	NeedsPrepare bool
 	NeedsExplain bool
+	// Check implements a built-in rule against the syntax tree of a query
+	Check builtinCheck
+	// Plugin implements the rule through the Vet RPC. It's called once per
+	// package, with every query in it.
+	Plugin  *config.Plugin
+	Options []byte
+}
+
+// severityRank orders severities, so problems can be compared to the
+// --fail-on threshold
+func severityRank(severity string) int {
+	switch severity {
+	case severityInfo:
+		return 0
+	case severityWarning:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func ruleSeverity(r config.Rule) (string, error) {
+	switch r.Severity {
+	case "":
+		return severityError, nil
+	case severityError, severityWarning, severityInfo:
+		return r.Severity, nil
+	default:
+		return "", fmt.Errorf("type-check error: %s: unknown severity %q: must be error, warning or info", r.Name, r.Severity)
+	}
 }
 
 type checker struct {
@@ -384,6 +509,12 @@ type checker struct {
 	Dir           string
 	Env           *cel.Env
 	Stderr        io.Writer
+	Stdout        io.Writer
+	Diags         *diagnostics
+	FailOn        string
+	Baseline      *vetBaseline
+	WriteBaseline bool
+	TopCost       int
 	OnlyManagedDB bool
 	Client        dbmanager.Client
 	Replacer      *shfmt.Replacer
@@ -402,24 +533,21 @@ func (c *checker) fetchDatabaseUri(ctx context.Context, s config.SQL) (string, f
 		return uri, cleanup, err
 	}
 
+	// Managed SQLite databases are in-memory databases created by the caller
+	if s.Engine == config.EngineSQLite {
+		return ":memory:", cleanup, nil
+	}
+
 	if c.Client == nil {
 		// FIXME: Eventual race condition
 		client := dbmanager.NewClient(c.Conf.Servers)
 		c.Client = client
 	}
 
-	var ddl []string
-	files, err := sqlpath.Glob(s.Schema)
+	ddl, err := readSchema(s)
 	if err != nil {
 		return "", cleanup, err
 	}
-	for _, schema := range files {
-		contents, err := os.ReadFile(schema)
-		if err != nil {
-			return "", cleanup, fmt.Errorf("read file: %w", err)
-		}
-		ddl = append(ddl, migrations.RemoveRollbackStatements(string(contents)))
-	}
 
 	resp, err := c.Client.CreateDatabase(ctx, &dbmanager.CreateDatabaseRequest{
 		Engine:     string(s.Engine),
@@ -444,6 +572,42 @@ func (c *checker) fetchDatabaseUri(ctx context.Context, s config.SQL) (string, f
 	return uri, cleanup, nil
 }
 
+func readSchema(s config.SQL) ([]string, error) {
+	var ddl []string
+	files, err := sqlpath.Glob(s.Schema)
+	if err != nil {
+		return nil, err
+	}
+	files, err = migrations.Order(files)
+	if err != nil {
+		return nil, err
+	}
+	for _, schema := range files {
+		contents, err := os.ReadFile(schema)
+		if err != nil {
+			return nil, fmt.Errorf("read file: %w", err)
+		}
+		ddl = append(ddl, migrations.RemoveRollbackStatements(string(contents)))
+	}
+	return ddl, nil
+}
+
+func applySchema(ctx context.Context, db *sql.DB, s config.SQL) error {
+	ddl, err := readSchema(s)
+	if err != nil {
+		return err
+	}
+	for _, q := range ddl {
+		if len(strings.TrimSpace(q)) == 0 {
+			continue
+		}
+		if _, err := db.ExecContext(ctx, q); err != nil {
+			return fmt.Errorf("%s: %s", q, err)
+		}
+	}
+	return nil
+}
+
 func (c *checker) DSN(dsn string) (string, error) {
 	return c.Replacer.Replace(dsn), nil
 }
@@ -470,7 +634,7 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 		Debug: debug.Debug,
 	}
 
-	result, failed := parse(ctx, name, c.Dir, s, combo, parseOpts, c.Stderr)
+	result, failed := parse(ctx, nil, name, c.Dir, s, combo, parseOpts, c.Diags, c.Stderr)
 	if failed {
 		return ErrFailedChecks
 	}
@@ -524,10 +688,18 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 				return fmt.Errorf("database: connection error: %s", err)
 			}
 			defer db.Close()
+			if s.Database.Managed {
+				// Every connection to ":memory:" opens a new database
+				db.SetMaxOpenConns(1)
+				if err := applySchema(ctx, db, s); err != nil {
+					return fmt.Errorf("database: %s", err)
+				}
+			}
 			prep = &dbPreparer{db}
-			// SQLite really doesn't want us to depend on the output of EXPLAIN
-			// QUERY PLAN: https://www.sqlite.org/eqp.html
-			expl = nil
+			// SQLite doesn't promise a stable EXPLAIN QUERY PLAN format
+			// (https://www.sqlite.org/eqp.html), so only the parts that have
+			// been stable for years are parsed.
+			expl = &sqliteExplainer{db}
 		default:
 			return fmt.Errorf("unsupported database uri: %s", s.Engine)
 		}
@@ -536,8 +708,102 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 	errored := false
 	req := codeGenRequest(result, combo)
 	cfg := vetConfig(req)
+	vcat := vetCatalog(result.Catalog)
+	locate := queryLocator(s)
+	// at returns the start of the i-th query, and nowhere for a negative i
+	at := func(i int) vetPosition {
+		var pos vetPosition
+		if i >= 0 {
+			pos.File, pos.Line, pos.Column = locate(result.Queries[i])
+		}
+		return pos
+	}
+	// failAt prints a problem with the i-th query, or collects it as a
+	// diagnostic at pos. A negative i reports a problem with the whole
+	// package. Problems below the --fail-on severity don't fail the checks.
+	failAt := func(i int, pos vetPosition, rule, severity, msg, line string) {
+		diag := Diagnostic{File: pos.File, Line: pos.Line, Column: pos.Column, Rule: rule, Severity: severity, Message: msg}
+		if i >= 0 {
+			diag.Query = req.Queries[i].Name
+		}
+		if severityRank(severity) >= severityRank(c.FailOn) {
+			errored = true
+		}
+		if c.Diags == nil {
+			if severity != severityError {
+				line = fmt.Sprintf("%s (%s)", line, severity)
+			}
+			fmt.Fprintln(c.Stderr, line)
+			return
+		}
+		c.Diags.add(diag)
+	}
+	// fail reports a problem at the start of the i-th query
+	fail := func(i int, rule, severity, msg, line string) {
+		failAt(i, at(i), rule, severity, msg, line)
+	}
+	// reportAt is like failAt for the findings of a rule, which are left out
+	// when they're in the baseline
+	reportAt := func(i int, pos vetPosition, rule, severity, msg, line string) {
+		if c.Baseline != nil {
+			var query string
+			if i >= 0 {
+				query = req.Queries[i].Name
+			}
+			f := c.Baseline.finding(c.Dir, pos.File, query, rule, msg)
+			if c.WriteBaseline {
+				c.Baseline.add(f)
+				return
+			}
+			if c.Baseline.has(f) {
+				return
+			}
+		}
+		failAt(i, pos, rule, severity, msg, line)
+	}
+	report := func(i int, rule, severity, msg, line string) {
+		reportAt(i, at(i), rule, severity, msg, line)
+	}
+	var costs []queryCost
+	if c.TopCost > 0 && s.Engine == config.EnginePostgreSQL && expl == nil {
+		return fmt.Errorf("--top-cost: database connection required")
+	}
 	for i, query := range req.Queries {
 		md := result.Queries[i].Metadata
+
+		// explain runs EXPLAIN on the query once, its output is shared by
+		// the rules, the budget and the cost report
+		var engineOutput *vetEngineOutput
+		explain := func(rule string) bool {
+			if engineOutput != nil {
+				return true
+			}
+			var msg string
+			if expl == nil {
+				msg = "error explaining query: database connection required"
+			} else if out, err := expl.Explain(ctx, query.Text, query.Params...); err != nil {
+				msg = fmt.Sprintf("error explaining query: %s", err)
+			} else {
+				engineOutput = out
+				return true
+			}
+			if rule == "" {
+				fail(i, rule, severityError, msg, fmt.Sprintf("%s: %s: %s", query.Filename, query.Name, msg))
+			} else {
+				fail(i, rule, severityError, msg, fmt.Sprintf("%s: %s: %s: %s", query.Filename, query.Name, rule, msg))
+			}
+			return false
+		}
+		if c.TopCost > 0 && s.Engine == config.EnginePostgreSQL && explain("") {
+			plan := engineOutput.PostgreSQL.GetExplain().GetPlan()
+			costs = append(costs, queryCost{
+				Filename: query.Filename,
+				Name:     query.Name,
+				Cost:     plan.GetTotalCost(),
+				Rows:     plan.GetPlanRows(),
+			})
+		}
+
 		if md.Flags[constants.QueryFlagSqlcVetDisable] {
 			// If the vet disable flag is specified without any rules listed, all rules are ignored.
 			if len(md.RuleSkiplist) == 0 {
@@ -549,16 +815,24 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 
 			// Rules which are listed to be disabled but not declared in the config file are rejected.
 			for r := range md.RuleSkiplist {
-				if !slices.Contains(s.Rules, r) {
-					fmt.Fprintf(c.Stderr, "%s: %s: rule-check error: rule %q does not exist in the config file\n", query.Filename, query.Name, r)
-					errored = true
+				if !slices.Contains(s.Rules, r) && r != constants.QueryRuleBudget {
+					msg := fmt.Sprintf("rule-check error: rule %q does not exist in the config file", r)
+					fail(i, "", severityError, msg, fmt.Sprintf("%s: %s: %s", query.Filename, query.Name, msg))
 				}
 			}
 		}
 
+		file, _, _ := locate(result.Queries[i])
+		vq := vetQuery(query, req.Catalog.DefaultSchema)
+		vq.Tables, vq.WhereColumns = vetTables(result.Catalog, result.Queries[i].RawStmt)
+		for flag := range md.Flags {
+			vq.Flags = append(vq.Flags, flag)
+		}
+		slices.Sort(vq.Flags)
 		evalMap := map[string]any{
-			"query":  vetQuery(query),
-			"config": cfg,
+			"query":   vq,
+			"config":  cfg,
+			"catalog": vcat,
 		}
 
 		for _, name := range s.Rules {
@@ -571,44 +845,44 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 				if !ok {
 					return fmt.Errorf("type-check error: a rule with the name '%s' does not exist", name)
 				}
+				if rule.Plugin != nil || c.excluded(s, file, name) {
+					continue
+				}
 
 				if rule.NeedsPrepare {
 					if prep == nil {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s: error preparing query: database connection required\n", query.Filename, query.Name, name)
-						errored = true
+						msg := "error preparing query: database connection required"
+						fail(i, name, severityError, msg, fmt.Sprintf("%s: %s: %s: %s", query.Filename, query.Name, name, msg))
 						continue
 					}
 					prepName := fmt.Sprintf("sqlc_vet_%d_%d", time.Now().Unix(), i)
 					if err := prep.Prepare(ctx, prepName, query.Text); err != nil {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s: error preparing query: %s\n", query.Filename, query.Name, name, err)
-						errored = true
+						msg := fmt.Sprintf("error preparing query: %s", err)
+						report(i, name, rule.Severity, msg, fmt.Sprintf("%s: %s: %s: %s", query.Filename, query.Name, name, msg))
 						continue
 					}
 				}
 
+				if rule.Check != nil {
+					for _, msg := range rule.Check(result.Catalog, s.Engine, result.Queries[i].RawStmt) {
+						report(i, name, rule.Severity, msg, fmt.Sprintf("%s: %s: %s: %s", query.Filename, query.Name, name, msg))
+					}
+					continue
+				}
+
 				// short-circuit for "sqlc/db-prepare" rule which doesn't have a CEL program
 				if rule.Program == nil {
 					continue
 				}
 
 				// Get explain output for this query if we need it
-				_, pgsqlOK := evalMap["postgresql"]
-				_, mysqlOK := evalMap["mysql"]
-				if rule.NeedsExplain && !(pgsqlOK || mysqlOK) {
-					if expl == nil {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s: error explaining query: database connection required\n", query.Filename, query.Name, name)
-						errored = true
-						continue
-					}
-					engineOutput, err := expl.Explain(ctx, query.Text, query.Params...)
-					if err != nil {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s: error explaining query: %s\n", query.Filename, query.Name, name, err)
-						errored = true
+				if rule.NeedsExplain {
+					if !explain(name) {
 						continue
 					}
-
 					evalMap["postgresql"] = engineOutput.PostgreSQL
 					evalMap["mysql"] = engineOutput.MySQL
+					evalMap["sqlite"] = engineOutput.SQLite
 				}
 
 				if debug.Debug.DumpVetEnv {
@@ -627,14 +901,96 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 				if tripped {
 					// TODO: Get line numbers in the output
 					if rule.Message == "" {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s\n", query.Filename, query.Name, name)
+						report(i, name, rule.Severity, fmt.Sprintf("failed rule %s", name), fmt.Sprintf("%s: %s: %s", query.Filename, query.Name, name))
 					} else {
-						fmt.Fprintf(c.Stderr, "%s: %s: %s: %s\n", query.Filename, query.Name, name, rule.Message)
+						report(i, name, rule.Severity, rule.Message, fmt.Sprintf("%s: %s: %s: %s", query.Filename, query.Name, name, rule.Message))
 					}
-					errored = true
 				}
 			}
 		}
+
+		if budget := md.Budget; budget != nil {
+			name := constants.QueryRuleBudget
+			if _, skip := md.RuleSkiplist[name]; skip || c.excluded(s, file, name) {
+				continue
+			}
+			if budget.Invalid != "" {
+				fail(i, name, severityError, budget.Invalid, fmt.Sprintf("%s: %s: %s: %s", query.Filename, query.Name, name, budget.Invalid))
+				continue
+			}
+			if s.Engine != config.EnginePostgreSQL {
+				msg := fmt.Sprintf("budgets are only checked for %s", config.EnginePostgreSQL)
+				fail(i, name, severityError, msg, fmt.Sprintf("%s: %s: %s: %s", query.Filename, query.Name, name, msg))
+				continue
+			}
+			if !explain(name) {
+				continue
+			}
+			for _, msg := range overBudget(budget, engineOutput.PostgreSQL.GetExplain().GetPlan()) {
+				report(i, name, c.Rules[name].Severity, msg, fmt.Sprintf("%s: %s: %s: %s", query.Filename, query.Name, name, msg))
+			}
+		}
+	}
+	if c.TopCost > 0 && s.Engine == config.EnginePostgreSQL {
+		c.writeCostReport(s, combo, costs)
+	}
+
+	for _, name := range s.Rules {
+		rule, ok := c.Rules[name]
+		if !ok || rule.Plugin == nil {
+			continue
+		}
+		// A plugin that fails is reported like a finding of its rule, the
+		// other rules still run
+		findings, err := vetPlugin(ctx, combo, name, rule, req)
+		if err != nil {
+			msg := fmt.Sprintf("error running vet plugin: %s", err)
+			fail(-1, name, severityError, msg, fmt.Sprintf("%s: %s", name, msg))
+			continue
+		}
+		for _, f := range findings {
+			msg := f.Message
+			if msg == "" {
+				msg = cmp.Or(rule.Message, fmt.Sprintf("failed rule %s", name))
+			}
+			i := slices.IndexFunc(req.Queries, func(q *plugin.Query) bool { return q.Name == f.Query })
+			if f.Query != "" && i < 0 {
+				msg = fmt.Sprintf("%s: %s", f.Query, msg)
+			}
+			pos := at(i)
+			switch {
+			case f.File != "":
+				pos = vetPosition{File: filepath.Join(c.Dir, f.File), Line: int(f.Line), Column: int(f.Column)}
+			case f.Line > 0 && pos.File != "":
+				pos.Line, pos.Column = int(f.Line), int(f.Column)
+			}
+			if i < 0 {
+				line := fmt.Sprintf("%s: %s", name, msg)
+				if pos.File != "" {
+					if c.excluded(s, pos.File, name) {
+						continue
+					}
+					line = fmt.Sprintf("%s: %s", pos.relative(c.Dir), line)
+				}
+				reportAt(-1, pos, name, rule.Severity, msg, line)
+				continue
+			}
+			md := result.Queries[i].Metadata
+			if md.Flags[constants.QueryFlagSqlcVetDisable] {
+				if _, skip := md.RuleSkiplist[name]; skip || len(md.RuleSkiplist) == 0 {
+					continue
+				}
+			}
+			if c.excluded(s, pos.File, name) {
+				continue
+			}
+			query := req.Queries[i]
+			where := query.Filename
+			if f.File != "" || f.Line > 0 {
+				where = pos.relative(c.Dir)
+			}
+			reportAt(i, pos, name, rule.Severity, msg, fmt.Sprintf("%s: %s: %s: %s", where, query.Name, name, msg))
+		}
 	}
 
 	if errored {
@@ -643,6 +999,84 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 	return nil
 }
 
+// excluded reports whether a rule is turned off for a query file by the
+// exclude_rules of its package
+func (c *checker) excluded(s config.SQL, file, rule string) bool {
+	for _, ex := range s.ExcludeRules {
+		if len(ex.Rules) > 0 && !slices.Contains(ex.Rules, rule) {
+			continue
+		}
+		path := filepath.Join(c.Dir, ex.Path)
+		if file == path || strings.HasPrefix(file, path+string(filepath.Separator)) {
+			return true
+		}
+		if ok, _ := filepath.Match(path, file); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// vetPosition is where a problem was found. File is empty for problems with
+// a whole package.
+type vetPosition struct {
+	File   string
+	Line   int
+	Column int
+}
+
+// relative formats the position for text output, with the file relative to
+// dir
+func (p vetPosition) relative(dir string) string {
+	file := p.File
+	if rel, err := filepath.Rel(dir, file); err == nil && !strings.HasPrefix(rel, "..") {
+		file = rel
+	}
+	switch {
+	case p.Line > 0 && p.Column > 0:
+		return fmt.Sprintf("%s:%d:%d", file, p.Line, p.Column)
+	case p.Line > 0:
+		return fmt.Sprintf("%s:%d", file, p.Line)
+	}
+	return file
+}
+
+// vetPlugin runs a rule implemented by a plugin against every query in a
+// package at once, so it can check invariants that span queries and tables
+func vetPlugin(ctx context.Context, combo config.CombinedSettings, name string, r rule, req *plugin.GenerateRequest) ([]*plugin.VetFinding, error) {
+	handler, err := pluginHandler(r.Plugin)
+	if err != nil {
+		return nil, err
+	}
+	return runVetPlugin(ctx, handler, combo, name, r, req)
+}
+
+// runVetPlugin sends the Vet request of a rule to the connection of its plugin
+func runVetPlugin(ctx context.Context, handler grpc.ClientConnInterface, combo config.CombinedSettings, name string, r rule, req *plugin.GenerateRequest) ([]*plugin.VetFinding, error) {
+	vetReq := &plugin.VetRequest{
+		Settings: req.Settings,
+		// WASM plugins trim the catalog in place
+		Catalog:     proto.Clone(req.Catalog).(*plugin.Catalog),
+		Queries:     req.Queries,
+		SqlcVersion: req.SqlcVersion,
+		Rule:        name,
+		RuleOptions: r.Options,
+	}
+	if global, found := combo.Global.Options[r.Plugin.Name]; found {
+		opts, err := convert.YAMLtoJSON(global)
+		if err != nil {
+			return nil, fmt.Errorf("invalid global options: %w", err)
+		}
+		vetReq.GlobalOptions = opts
+	}
+	client := plugin.NewCodegenServiceClient(handler)
+	resp, err := client.Vet(ctx, vetReq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Findings, nil
+}
+
 func vetConfig(req *plugin.GenerateRequest) *vet.Config {
 	return &vet.Config{
 		Version: req.Settings.Version,
@@ -652,22 +1086,86 @@ func vetConfig(req *plugin.GenerateRequest) *vet.Config {
 	}
 }
 
-func vetQuery(q *plugin.Query) *vet.Query {
+func vetQuery(q *plugin.Query, defaultSchema string) *vet.Query {
 	var params []*vet.Parameter
 	for _, p := range q.Params {
-		params = append(params, &vet.Parameter{
+		param := &vet.Parameter{
 			Number: p.Number,
-		})
+		}
+		if col := p.Column; col != nil {
+			param.Name = col.Name
+			param.Type = vetColumnType(col)
+			param.NotNull = col.NotNull
+			param.IsArray = col.IsArray || col.IsSqlcSlice
+		}
+		params = append(params, param)
+	}
+	var columns []*vet.Column
+	for _, col := range q.Columns {
+		vc := &vet.Column{
+			Name:    col.Name,
+			Type:    vetColumnType(col),
+			NotNull: col.NotNull,
+			IsArray: col.IsArray,
+			Comment: col.Comment,
+		}
+		if col.Table != nil {
+			vc.Schema = cmp.Or(col.Table.Schema, defaultSchema)
+			vc.Table = col.Table.Name
+		}
+		columns = append(columns, vc)
 	}
 	return &vet.Query{
-		Sql:    q.Text,
-		Name:   q.Name,
-		Cmd:    strings.TrimPrefix(q.Cmd, ":"),
-		Params: params,
+		Sql:     q.Text,
+		Name:    q.Name,
+		Cmd:     strings.TrimPrefix(q.Cmd, ":"),
+		Params:  params,
+		Columns: columns,
+	}
+}
+
+func vetColumnType(col *plugin.Column) string {
+	if col.EmbedTable != nil {
+		return vetType(col.EmbedTable.Schema, col.EmbedTable.Name)
+	}
+	if col.Type == nil {
+		return ""
 	}
+	return vetType(col.Type.Schema, col.Type.Name)
 }
 
 type vetEngineOutput struct {
 	PostgreSQL *vet.PostgreSQL
 	MySQL      *vet.MySQL
+	SQLite     *vet.SQLite
+}
+
+// queryLocator returns a function finding the file, line and column a query
+// starts at. Query files are read once, when a query in them is first
+// located.
+func queryLocator(s config.SQL) func(*compiler.Query) (string, int, int) {
+	files, _ := sqlpath.Glob(s.Queries)
+	contents := map[string]string{}
+	return func(q *compiler.Query) (string, int, int) {
+		for _, file := range files {
+			if filepath.Base(file) != q.Metadata.Filename {
+				continue
+			}
+			src, ok := contents[file]
+			if !ok {
+				blob, err := os.ReadFile(file)
+				if err != nil {
+					return file, 0, 0
+				}
+				src = string(blob)
+				contents[file] = src
+			}
+			if q.RawStmt == nil {
+				return file, 0, 0
+			}
+			line, col := source.LineNumber(src, q.RawStmt.StmtLocation)
+			return file, line, col
+		}
+		return q.Metadata.Filename, 0, 0
+	}
 }
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// A vetBaseline lists known findings that don't fail sqlc vet, so new rules
+// can be adopted without fixing every existing query first
+type vetBaseline struct {
+	Findings []baselineFinding `json:"findings"`
+
+	known map[baselineFinding]bool
+}
+
+// Findings are matched by query rather than by line, so unrelated edits to a
+// query file don't invalidate the baseline
+type baselineFinding struct {
+	File    string `json:"file,omitempty"`
+	Query   string `json:"query,omitempty"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func readBaseline(path string) (*vetBaseline, error) {
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("baseline %s does not exist, create it with --update-baseline", path)
+		}
+		return nil, err
+	}
+	var b vetBaseline
+	if err := json.Unmarshal(blob, &b); err != nil {
+		return nil, fmt.Errorf("invalid baseline %s: %w", path, err)
+	}
+	b.known = map[baselineFinding]bool{}
+	for _, f := range b.Findings {
+		b.known[f] = true
+	}
+	return &b, nil
+}
+
+// finding returns the baseline entry of a problem. Query files are relative to
+// the directory of the configuration file.
+func (b *vetBaseline) finding(dir, file, query, rule, msg string) baselineFinding {
+	if rel, err := filepath.Rel(dir, file); err == nil && file != "" {
+		file = rel
+	}
+	return baselineFinding{
+		File:    filepath.ToSlash(file),
+		Query:   query,
+		Rule:    rule,
+		Message: msg,
+	}
+}
+
+func (b *vetBaseline) has(f baselineFinding) bool {
+	return b.known[f]
+}
+
+func (b *vetBaseline) add(f baselineFinding) {
+	if !slices.Contains(b.Findings, f) {
+		b.Findings = append(b.Findings, f)
+	}
+}
+
+func (b *vetBaseline) write(path string) error {
+	slices.SortFunc(b.Findings, func(x, y baselineFinding) int {
+		return cmp.Or(
+			cmp.Compare(x.File, y.File),
+			cmp.Compare(x.Query, y.Query),
+			cmp.Compare(x.Rule, y.Rule),
+			cmp.Compare(x.Message, y.Message),
+		)
+	})
+	if b.Findings == nil {
+		b.Findings = []baselineFinding{}
+	}
+	blob, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(blob, '\n'), 0644)
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+func TestBaselineRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "baseline.json")
+
+	b := &vetBaseline{}
+	b.add(b.finding(dir, filepath.Join(dir, "query", "reports.sql"), "DeleteAuthors", "no-delete", "deletes rows"))
+	b.add(b.finding(dir, filepath.Join(dir, "query", "authors.sql"), "ListAuthors", "no-limit", "missing LIMIT"))
+	b.add(b.finding(dir, "", "", "plural-tables", "table book isn't plural"))
+	// The same finding from a second package is only listed once
+	b.add(b.finding(dir, filepath.Join(dir, "query", "authors.sql"), "ListAuthors", "no-limit", "missing LIMIT"))
+	if err := b.write(path); err != nil {
+		t.Fatal(err)
+	}
+
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+  "findings": [
+    {
+      "rule": "plural-tables",
+      "message": "table book isn't plural"
+    },
+    {
+      "file": "query/authors.sql",
+      "query": "ListAuthors",
+      "rule": "no-limit",
+      "message": "missing LIMIT"
+    },
+    {
+      "file": "query/reports.sql",
+      "query": "DeleteAuthors",
+      "rule": "no-delete",
+      "message": "deletes rows"
+    }
+  ]
+}
+`
+	if diff := cmp.Diff(want, string(blob)); diff != "" {
+		t.Errorf("baseline mismatch:\n%s", diff)
+	}
+
+	read, err := readBaseline(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		file, query, rule, msg string
+		known                  bool
+	}{
+		{filepath.Join(dir, "query", "authors.sql"), "ListAuthors", "no-limit", "missing LIMIT", true},
+		{"", "", "plural-tables", "table book isn't plural", true},
+		// Findings only match with the same query, rule and message
+		{filepath.Join(dir, "query", "authors.sql"), "ListBooks", "no-limit", "missing LIMIT", false},
+		{filepath.Join(dir, "query", "authors.sql"), "ListAuthors", "no-delete", "missing LIMIT", false},
+		{filepath.Join(dir, "query", "authors.sql"), "ListAuthors", "no-limit", "changed message", false},
+		{filepath.Join(dir, "query", "books.sql"), "ListAuthors", "no-limit", "missing LIMIT", false},
+	} {
+		if got := read.has(read.finding(dir, tc.file, tc.query, tc.rule, tc.msg)); got != tc.known {
+			t.Errorf("%s %s %s: got %t, want %t", tc.query, tc.rule, tc.msg, got, tc.known)
+		}
+	}
+}
+
+func TestBaselineEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := (&vetBaseline{}).write(path); err != nil {
+		t.Fatal(err)
+	}
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "{\n  \"findings\": []\n}\n"; string(blob) != want {
+		t.Errorf("got %q, want %q", blob, want)
+	}
+}
+
+func TestReadBaselineErrors(t *testing.T) {
+	dir := t.TempDir()
+	_, err := readBaseline(filepath.Join(dir, "missing.json"))
+	if err == nil || !strings.Contains(err.Error(), "create it with --update-baseline") {
+		t.Errorf("missing baseline: got %v", err)
+	}
+
+	invalid := filepath.Join(dir, "invalid.json")
+	if err := os.WriteFile(invalid, []byte("findings:"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = readBaseline(invalid)
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid baseline") {
+		t.Errorf("invalid baseline: got %v", err)
+	}
+}
+
+func TestExcluded(t *testing.T) {
+	c := &checker{Dir: "/work"}
+	s := config.SQL{
+		ExcludeRules: []config.Exclude{
+			{Path: "query/legacy", Rules: []string{"no-limit"}},
+			{Path: "query/*_gen.sql"},
+		},
+	}
+	for _, tc := range []struct {
+		file, rule string
+		want       bool
+	}{
+		{"/work/query/legacy/authors.sql", "no-limit", true},
+		{"/work/query/legacy/authors.sql", "no-delete", false},
+		{"/work/query/legacy", "no-limit", true},
+		{"/work/query/legacy_authors.sql", "no-limit", false},
+		{"/work/query/authors_gen.sql", "no-delete", true},
+		{"/work/query/authors.sql", "no-limit", false},
+	} {
+		if got := c.excluded(s, tc.file, tc.rule); got != tc.want {
+			t.Errorf("%s %s: got %t, want %t", tc.file, tc.rule, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"cmp"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+	"github.com/sqlc-dev/sqlc/internal/vet"
+)
+
+// overBudget compares the estimates of the top node of a PostgreSQL plan,
+// which include every node below it, to the budget of a query
+func overBudget(b *metadata.Budget, plan *vet.PostgreSQLExplain_Plan) []string {
+	var msgs []string
+	if cost := plan.GetTotalCost(); b.Cost > 0 && float64(cost) >= b.Cost {
+		// Costs are sent as 32-bit floats, which are formatted at that
+		// precision so 35.1 isn't printed as 35.099998474121094
+		msgs = append(msgs, fmt.Sprintf("estimated cost %s exceeds the budget of cost<%s", strconv.FormatFloat(float64(cost), 'f', -1, 32), formatLimit(b.Cost)))
+	}
+	if rows := plan.GetPlanRows(); b.Rows > 0 && float64(rows) >= b.Rows {
+		msgs = append(msgs, fmt.Sprintf("estimated %d rows exceed the budget of rows<%s", rows, formatLimit(b.Rows)))
+	}
+	return msgs
+}
+
+// formatLimit formats a limit of a budget like it was written
+func formatLimit(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+type queryCost struct {
+	Filename string
+	Name     string
+	Cost     float32
+	Rows     uint64
+}
+
+// writeCostReport prints the --top-cost queries of a package with the highest
+// estimated cost
+func (c *checker) writeCostReport(s config.SQL, combo config.CombinedSettings, costs []queryCost) {
+	slices.SortStableFunc(costs, func(a, b queryCost) int {
+		return cmp.Or(
+			cmp.Compare(b.Cost, a.Cost),
+			cmp.Compare(b.Rows, a.Rows),
+		)
+	})
+	costs = costs[:min(c.TopCost, len(costs))]
+
+	name := s.Name
+	if name == "" && s.Gen.Go != nil {
+		name = combo.Go.Package
+	}
+	if name == "" {
+		var queries []string
+		for _, q := range s.Queries {
+			if rel, err := filepath.Rel(c.Dir, q); err == nil {
+				q = rel
+			}
+			queries = append(queries, q)
+		}
+		name = strings.Join(queries, ", ")
+	}
+	fmt.Fprintf(c.Stdout, "# package %s\n", name)
+	tw := tabwriter.NewWriter(c.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "cost\trows\t")
+	for _, q := range costs {
+		fmt.Fprintf(tw, "%.2f\t%d\t  %s: %s\n", q.Cost, q.Rows, q.Filename, q.Name)
+	}
+	tw.Flush()
+}
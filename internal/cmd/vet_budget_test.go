@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+	"github.com/sqlc-dev/sqlc/internal/vet"
+)
+
+func TestOverBudget(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		budget metadata.Budget
+		cost   float32
+		rows   uint64
+		want   []string
+	}{
+		{
+			name:   "Under",
+			budget: metadata.Budget{Cost: 100, Rows: 10},
+			cost:   99.5,
+			rows:   9,
+		},
+		{
+			name:   "AtLimit",
+			budget: metadata.Budget{Cost: 100, Rows: 10},
+			cost:   100,
+			rows:   10,
+			want: []string{
+				"estimated cost 100 exceeds the budget of cost<100",
+				"estimated 10 rows exceed the budget of rows<10",
+			},
+		},
+		{
+			name:   "CostOnly",
+			budget: metadata.Budget{Cost: 10},
+			cost:   35.1,
+			rows:   1000000,
+			want:   []string{"estimated cost 35.1 exceeds the budget of cost<10"},
+		},
+		{
+			name:   "RowsOnly",
+			budget: metadata.Budget{Rows: 1000.5},
+			cost:   1e9,
+			rows:   2000,
+			want:   []string{"estimated 2000 rows exceed the budget of rows<1000.5"},
+		},
+		{
+			name:   "PreciseLimit",
+			budget: metadata.Budget{Cost: 0.123456789},
+			cost:   0.25,
+			want:   []string{"estimated cost 0.25 exceeds the budget of cost<0.123456789"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := &vet.PostgreSQLExplain_Plan{TotalCost: tc.cost, PlanRows: tc.rows}
+			got := overBudget(&tc.budget, plan)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("messages mismatch:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteCostReport(t *testing.T) {
+	costs := []queryCost{
+		{Filename: "query.sql", Name: "GetAuthor", Cost: 8.17, Rows: 1},
+		{Filename: "query.sql", Name: "ListAuthors", Cost: 1234.5, Rows: 10000},
+		{Filename: "books.sql", Name: "ListBooks", Cost: 1234.5, Rows: 50000},
+	}
+	report := `# package %s
+     cost   rows
+  1234.50  50000  books.sql: ListBooks
+  1234.50  10000  query.sql: ListAuthors
+`
+	for _, tc := range []struct {
+		name string
+		s    config.SQL
+		pkg  string
+		want string
+	}{
+		{
+			name: "Name",
+			s:    config.SQL{Name: "authors", Gen: config.SQLGen{Go: &opts.Options{}}},
+			pkg:  "db",
+			want: "authors",
+		},
+		{
+			name: "GoPackage",
+			s:    config.SQL{Gen: config.SQLGen{Go: &opts.Options{}}},
+			pkg:  "db",
+			want: "db",
+		},
+		{
+			name: "Queries",
+			s:    config.SQL{Queries: []string{"/work/query.sql", "/work/sql/books.sql"}},
+			want: "query.sql, sql/books.sql",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			c := &checker{Dir: "/work", Stdout: &stdout, TopCost: 2}
+			combo := config.CombinedSettings{Go: opts.Options{Package: tc.pkg}}
+			c.writeCostReport(tc.s, combo, append([]queryCost(nil), costs...))
+			want := fmt.Sprintf(report, tc.want)
+			if diff := cmp.Diff(want, stdout.String()); diff != "" {
+				t.Errorf("report mismatch:\n%s", diff)
+			}
+		})
+	}
+}
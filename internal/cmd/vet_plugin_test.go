@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// vetConn answers the Vet RPC like a plugin would
+type vetConn struct {
+	method string
+	req    *plugin.VetRequest
+	resp   *plugin.VetResponse
+	err    error
+}
+
+func (c *vetConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	c.method = method
+	c.req = args.(*plugin.VetRequest)
+	// Plugins are free to edit their request, as WASM plugins do
+	c.req.Catalog.Schemas = nil
+	if c.err != nil {
+		return c.err
+	}
+	proto.Merge(reply.(*plugin.VetResponse), c.resp)
+	return nil
+}
+
+func (c *vetConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestRunVetPlugin(t *testing.T) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte("strict: true"), &doc); err != nil {
+		t.Fatal(err)
+	}
+	global := *doc.Content[0]
+	plug := &config.Plugin{Name: "conventions"}
+	combo := config.CombinedSettings{
+		Global: config.Config{Options: map[string]yaml.Node{"conventions": global}},
+	}
+	r := rule{Plugin: plug, Options: []byte(`{"exceptions":["staff"]}`)}
+	req := &plugin.GenerateRequest{
+		Settings:    &plugin.Settings{Engine: "postgresql"},
+		Catalog:     &plugin.Catalog{DefaultSchema: "public", Schemas: []*plugin.Schema{{Name: "public"}}},
+		Queries:     []*plugin.Query{{Name: "ListAuthors", Cmd: ":many"}},
+		SqlcVersion: "v1.0.0",
+	}
+	conn := &vetConn{resp: &plugin.VetResponse{Findings: []*plugin.VetFinding{
+		{Query: "ListAuthors", Message: "missing LIMIT", Line: 3},
+		{File: "schema.sql", Message: "table book isn't plural"},
+	}}}
+
+	findings, err := runVetPlugin(context.Background(), conn, combo, "require-limit", r, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if conn.method != "/plugin.CodegenService/Vet" {
+		t.Errorf("method: got %q", conn.method)
+	}
+	type sent struct {
+		Rule, Version, Engine string
+		RuleOptions, Global   string
+		Queries               []string
+	}
+	got := sent{
+		Rule:        conn.req.Rule,
+		Version:     conn.req.SqlcVersion,
+		Engine:      conn.req.Settings.GetEngine(),
+		RuleOptions: string(conn.req.RuleOptions),
+		Global:      string(conn.req.GlobalOptions),
+	}
+	for _, q := range conn.req.Queries {
+		got.Queries = append(got.Queries, q.Name)
+	}
+	want := sent{
+		Rule:        "require-limit",
+		Version:     "v1.0.0",
+		Engine:      "postgresql",
+		RuleOptions: `{"exceptions":["staff"]}`,
+		Global:      `{"strict":true}`,
+		Queries:     []string{"ListAuthors"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("request mismatch:\n%s", diff)
+	}
+	if len(req.Catalog.Schemas) != 1 {
+		t.Error("the plugin edited the catalog shared with the other rules")
+	}
+
+	type finding struct {
+		Query, File, Message string
+		Line                 int32
+	}
+	var gotFindings []finding
+	for _, f := range findings {
+		gotFindings = append(gotFindings, finding{f.Query, f.File, f.Message, f.Line})
+	}
+	wantFindings := []finding{
+		{Query: "ListAuthors", Message: "missing LIMIT", Line: 3},
+		{File: "schema.sql", Message: "table book isn't plural"},
+	}
+	if diff := cmp.Diff(wantFindings, gotFindings); diff != "" {
+		t.Errorf("findings mismatch:\n%s", diff)
+	}
+
+	conn.err = errors.New("plugin crashed")
+	if _, err := runVetPlugin(context.Background(), conn, combo, "require-limit", r, req); err == nil {
+		t.Error("expected the error of the plugin")
+	}
+}
+
+func TestVetPositionRelative(t *testing.T) {
+	for _, tc := range []struct {
+		pos  vetPosition
+		want string
+	}{
+		{vetPosition{File: "/work/query.sql"}, "query.sql"},
+		{vetPosition{File: "/work/sql/query.sql", Line: 3}, "sql/query.sql:3"},
+		{vetPosition{File: "/work/query.sql", Line: 3, Column: 7}, "query.sql:3:7"},
+		{vetPosition{File: "/other/query.sql", Line: 1}, "/other/query.sql:1"},
+	} {
+		if got := tc.pos.relative("/work"); got != tc.want {
+			t.Errorf("got %q, want %q", got, tc.want)
+		}
+	}
+}
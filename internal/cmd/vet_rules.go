@@ -0,0 +1,490 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/constants"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/vet"
+)
+
+// A builtinCheck looks for a problem in the syntax tree of a query and
+// returns a message for each occurrence
+type builtinCheck func(c *catalog.Catalog, engine config.Engine, raw *ast.RawStmt) []string
+
+// builtinChecks are the rules shipped with sqlc that don't need a database.
+// They only run when they're listed in the rules of a package.
+var builtinChecks = map[string]builtinCheck{
+	constants.QueryRuleMissingWhere:        checkMissingWhere,
+	constants.QueryRuleSelectStar:          checkSelectStar,
+	constants.QueryRuleLimitWithoutOrderBy: checkLimitWithoutOrderBy,
+	constants.QueryRuleNotInNullable:       checkNotInNullable,
+	constants.QueryRuleImplicitCrossJoin:   checkImplicitCrossJoin,
+	constants.QueryRuleOffsetPagination:    checkOffsetPagination,
+}
+
+// The PostgreSQL parser fills unset nodes with *ast.TODO
+func isSet(n ast.Node) bool {
+	if n == nil {
+		return false
+	}
+	_, todo := n.(*ast.TODO)
+	return !todo
+}
+
+func hasItems(l *ast.List) bool {
+	return l != nil && len(l.Items) > 0
+}
+
+func searchSelects(raw *ast.RawStmt) []*ast.SelectStmt {
+	var selects []*ast.SelectStmt
+	for _, node := range astutils.Search(raw, func(node ast.Node) bool {
+		_, ok := node.(*ast.SelectStmt)
+		return ok
+	}).Items {
+		selects = append(selects, node.(*ast.SelectStmt))
+	}
+	return selects
+}
+
+func relationName(relations *ast.List) string {
+	if relations == nil {
+		return ""
+	}
+	for _, item := range relations.Items {
+		if rv, ok := item.(*ast.RangeVar); ok && rv.Relname != nil {
+			return *rv.Relname
+		}
+	}
+	return ""
+}
+
+func checkMissingWhere(_ *catalog.Catalog, _ config.Engine, raw *ast.RawStmt) []string {
+	var msgs []string
+	for _, node := range astutils.Search(raw, func(node ast.Node) bool {
+		switch node.(type) {
+		case *ast.UpdateStmt, *ast.DeleteStmt:
+			return true
+		}
+		return false
+	}).Items {
+		switch n := node.(type) {
+		case *ast.UpdateStmt:
+			if !isSet(n.WhereClause) {
+				msgs = append(msgs, fmt.Sprintf("UPDATE without a WHERE clause changes every row of %s", relationName(n.Relations)))
+			}
+		case *ast.DeleteStmt:
+			if !isSet(n.WhereClause) {
+				msgs = append(msgs, fmt.Sprintf("DELETE without a WHERE clause removes every row of %s", relationName(n.Relations)))
+			}
+		}
+	}
+	return msgs
+}
+
+// checkSelectStar only looks at the columns a query returns. Stars in
+// subqueries and EXISTS clauses don't reach the application.
+func checkSelectStar(_ *catalog.Catalog, _ config.Engine, raw *ast.RawStmt) []string {
+	// The lists of returned columns by the clause they're in
+	var targets []*ast.List
+	var clauses []string
+	var collect func(n *ast.SelectStmt)
+	collect = func(n *ast.SelectStmt) {
+		if n == nil {
+			return
+		}
+		if n.Larg != nil || n.Rarg != nil {
+			collect(n.Larg)
+			collect(n.Rarg)
+			return
+		}
+		targets = append(targets, n.TargetList)
+		clauses = append(clauses, "SELECT")
+	}
+	switch n := raw.Stmt.(type) {
+	case *ast.SelectStmt:
+		collect(n)
+	case *ast.InsertStmt:
+		targets = append(targets, n.ReturningList)
+		clauses = append(clauses, "RETURNING")
+	case *ast.UpdateStmt:
+		targets = append(targets, n.ReturningList)
+		clauses = append(clauses, "RETURNING")
+	case *ast.DeleteStmt:
+		targets = append(targets, n.ReturningList)
+		clauses = append(clauses, "RETURNING")
+	}
+
+	var msgs []string
+	for i, list := range targets {
+		if list == nil {
+			continue
+		}
+		for _, item := range list.Items {
+			res, ok := item.(*ast.ResTarget)
+			if !ok {
+				continue
+			}
+			ref, ok := res.Val.(*ast.ColumnRef)
+			if !ok || ref.Fields == nil || len(ref.Fields.Items) == 0 {
+				continue
+			}
+			if _, star := ref.Fields.Items[len(ref.Fields.Items)-1].(*ast.A_Star); !star {
+				continue
+			}
+			var parts []string
+			for _, field := range ref.Fields.Items[:len(ref.Fields.Items)-1] {
+				if s, ok := field.(*ast.String); ok {
+					parts = append(parts, s.Str)
+				}
+			}
+			msgs = append(msgs, fmt.Sprintf("%s %s returns every column, list the columns the query needs instead", clauses[i], strings.Join(append(parts, "*"), ".")))
+		}
+	}
+	return msgs
+}
+
+func checkLimitWithoutOrderBy(_ *catalog.Catalog, _ config.Engine, raw *ast.RawStmt) []string {
+	// The rows of EXISTS subqueries are never read
+	exists := map[ast.Node]bool{}
+	for _, node := range astutils.Search(raw, func(node ast.Node) bool {
+		sub, ok := node.(*ast.SubLink)
+		return ok && sub.SubLinkType == ast.EXISTS_SUBLINK
+	}).Items {
+		exists[node.(*ast.SubLink).Subselect] = true
+	}
+
+	var msgs []string
+	for _, n := range searchSelects(raw) {
+		if exists[n] || !isSet(n.LimitCount) || ordered(n) {
+			continue
+		}
+		msgs = append(msgs, "LIMIT without ORDER BY returns an arbitrary subset of the rows")
+	}
+	return msgs
+}
+
+// ordered reports whether a SELECT has an ORDER BY clause. The MySQL and
+// SQLite parsers keep it in the window clause, as a list of expressions.
+func ordered(n *ast.SelectStmt) bool {
+	if hasItems(n.SortClause) {
+		return true
+	}
+	if n.WindowClause == nil {
+		return false
+	}
+	return slices.ContainsFunc(n.WindowClause.Items, func(item ast.Node) bool {
+		l, ok := item.(*ast.List)
+		return ok && len(l.Items) > 0
+	})
+}
+
+// PostgreSQL's IS_NOT_NULL null test type
+const nullTestIsNotNull ast.NullTestType = 2
+
+// checkNotInNullable reports NOT IN subqueries returning a column that can be
+// NULL. A single NULL makes NOT IN false for every row.
+func checkNotInNullable(c *catalog.Catalog, _ config.Engine, raw *ast.RawStmt) []string {
+	var subselects []ast.Node
+	for _, node := range astutils.Search(raw, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.In:
+			return n.Not
+		case *ast.BoolExpr:
+			return n.Boolop == ast.BoolExprTypeNot
+		}
+		return false
+	}).Items {
+		switch n := node.(type) {
+		case *ast.In:
+			if n.Sel != nil {
+				subselects = append(subselects, n.Sel)
+			} else if len(n.List) == 1 {
+				subselects = append(subselects, n.List[0])
+			}
+		case *ast.BoolExpr:
+			if n.Args == nil || len(n.Args.Items) != 1 {
+				continue
+			}
+			if sub, ok := n.Args.Items[0].(*ast.SubLink); ok && sub.SubLinkType == ast.ANY_SUBLINK {
+				subselects = append(subselects, sub.Subselect)
+			}
+		}
+	}
+
+	var msgs []string
+	for _, node := range subselects {
+		sel, ok := node.(*ast.SelectStmt)
+		if !ok || sel.TargetList == nil || len(sel.TargetList.Items) != 1 {
+			continue
+		}
+		res, ok := sel.TargetList.Items[0].(*ast.ResTarget)
+		if !ok {
+			continue
+		}
+		ref, ok := res.Val.(*ast.ColumnRef)
+		if !ok || sel.FromClause == nil {
+			continue
+		}
+		tables, named := queryTables(c, sel.FromClause)
+		col := resolveColumnRef(tables, named, ref)
+		if col == nil || columnNotNull(tables, col) || filtersNulls(sel.WhereClause, col.Name) {
+			continue
+		}
+		msgs = append(msgs, fmt.Sprintf("NOT IN with a subquery returning nullable column %s.%s matches no rows when the subquery returns NULL, use NOT EXISTS instead", col.Table, col.Name))
+	}
+	return msgs
+}
+
+func columnNotNull(tables []*vet.Table, ref *vet.ColumnRef) bool {
+	for _, t := range tables {
+		if t.Schema != ref.Schema || t.Name != ref.Table {
+			continue
+		}
+		for _, col := range t.ColumnDefs {
+			if col.Name == ref.Name {
+				return col.NotNull
+			}
+		}
+	}
+	return false
+}
+
+// filtersNulls reports whether a WHERE clause has an IS NOT NULL test on a
+// column. PostgreSQL parses it as a null test, MySQL as a boolean expression.
+func filtersNulls(where ast.Node, name string) bool {
+	if !isSet(where) {
+		return false
+	}
+	return len(astutils.Search(where, func(node ast.Node) bool {
+		var arg ast.Node
+		switch n := node.(type) {
+		case *ast.NullTest:
+			if n.Nulltesttype != nullTestIsNotNull {
+				return false
+			}
+			arg = n.Arg
+		case *ast.BoolExpr:
+			if n.Boolop != ast.BoolExprTypeIsNotNull || n.Args == nil || len(n.Args.Items) != 1 {
+				return false
+			}
+			arg = n.Args.Items[0]
+		default:
+			return false
+		}
+		ref, ok := arg.(*ast.ColumnRef)
+		if !ok || ref.Fields == nil || len(ref.Fields.Items) == 0 {
+			return false
+		}
+		s, ok := ref.Fields.Items[len(ref.Fields.Items)-1].(*ast.String)
+		return ok && s.Str == name
+	}).Items) > 0
+}
+
+// checkImplicitCrossJoin reports tables listed in a FROM clause with nothing in
+// the WHERE clause connecting them to the other tables. Explicit CROSS JOINs
+// aren't reported, except with MySQL, which parses them like comma joins.
+func checkImplicitCrossJoin(c *catalog.Catalog, engine config.Engine, raw *ast.RawStmt) []string {
+	var msgs []string
+	for _, n := range searchSelects(raw) {
+		if n.FromClause == nil {
+			continue
+		}
+		var items []ast.Node
+		var flatten func(node ast.Node)
+		flatten = func(node ast.Node) {
+			switch n := node.(type) {
+			case *ast.List:
+				for _, item := range n.Items {
+					flatten(item)
+				}
+				return
+			case *ast.JoinExpr:
+				if engine == config.EngineMySQL && !isSet(n.Quals) && !hasItems(n.UsingClause) && !n.IsNatural {
+					flatten(n.Larg)
+					flatten(n.Rarg)
+					return
+				}
+			}
+			items = append(items, node)
+		}
+		flatten(n.FromClause)
+		if len(items) < 2 {
+			continue
+		}
+
+		// Every FROM item is a group of names it can be referenced by. Items
+		// other than tables and joins, like functions and LATERAL subqueries,
+		// often produce a single row, so they're never reported.
+		groups := make([][]string, len(items))
+		checked := make([]bool, len(items))
+		var tables []*vet.Table
+		owner := map[*vet.Table]int{}
+		for i, item := range items {
+			switch item.(type) {
+			case *ast.RangeVar, *ast.JoinExpr:
+				checked[i] = true
+			}
+			for _, node := range astutils.Search(item, func(node ast.Node) bool {
+				_, ok := node.(*ast.RangeVar)
+				return ok
+			}).Items {
+				rv := node.(*ast.RangeVar)
+				if rv.Relname == nil {
+					continue
+				}
+				name := *rv.Relname
+				if rv.Alias != nil && rv.Alias.Aliasname != nil {
+					name = *rv.Alias.Aliasname
+				}
+				groups[i] = append(groups[i], name)
+				ts, _ := queryTables(c, &ast.List{Items: []ast.Node{rv}})
+				for _, t := range ts {
+					tables = append(tables, t)
+					owner[t] = i
+				}
+			}
+			switch n := item.(type) {
+			case *ast.RangeSubselect:
+				if n.Alias != nil && n.Alias.Aliasname != nil {
+					groups[i] = append(groups[i], *n.Alias.Aliasname)
+				}
+			case *ast.RangeFunction:
+				if n.Alias != nil && n.Alias.Aliasname != nil {
+					groups[i] = append(groups[i], *n.Alias.Aliasname)
+				}
+			}
+		}
+		group := func(ref *ast.ColumnRef) int {
+			var parts []string
+			if ref.Fields != nil {
+				for _, item := range ref.Fields.Items {
+					if s, ok := item.(*ast.String); ok {
+						parts = append(parts, s.Str)
+					}
+				}
+			}
+			switch len(parts) {
+			case 0:
+				return -1
+			case 1:
+				found := -1
+				for _, t := range tables {
+					if slices.Contains(t.Columns, parts[0]) {
+						if found >= 0 && found != owner[t] {
+							return -1
+						}
+						found = owner[t]
+					}
+				}
+				return found
+			default:
+				return slices.IndexFunc(groups, func(names []string) bool {
+					return slices.Contains(names, parts[len(parts)-2])
+				})
+			}
+		}
+
+		// Each condition connects the items its columns belong to
+		connected := make([]int, len(items))
+		for i := range connected {
+			connected[i] = i
+		}
+		root := func(i int) int {
+			for connected[i] != i {
+				i = connected[i]
+			}
+			return i
+		}
+		for _, cond := range conjuncts(n.WhereClause) {
+			first := -1
+			for _, node := range astutils.Search(cond, func(node ast.Node) bool {
+				_, ok := node.(*ast.ColumnRef)
+				return ok
+			}).Items {
+				g := group(node.(*ast.ColumnRef))
+				if g < 0 {
+					continue
+				}
+				if first < 0 {
+					first = g
+					continue
+				}
+				connected[root(g)] = root(first)
+			}
+		}
+		// Functions and subqueries are connected to the items they refer to
+		for i, item := range items {
+			if checked[i] {
+				continue
+			}
+			for _, node := range astutils.Search(item, func(node ast.Node) bool {
+				_, ok := node.(*ast.ColumnRef)
+				return ok
+			}).Items {
+				if g := group(node.(*ast.ColumnRef)); g >= 0 && g != i {
+					connected[root(g)] = root(i)
+				}
+			}
+		}
+
+		// Items outside the largest connected set are reported
+		size := map[int]int{}
+		main := root(0)
+		for i := range items {
+			size[root(i)]++
+			if size[root(i)] > size[main] {
+				main = root(i)
+			}
+		}
+		for i, item := range items {
+			if checked[i] && root(i) != main {
+				msgs = append(msgs, fmt.Sprintf("%s is joined to the other tables without a join condition", fromName(item, groups[i])))
+			}
+		}
+	}
+	return msgs
+}
+
+func fromName(item ast.Node, names []string) string {
+	if rv, ok := item.(*ast.RangeVar); ok && rv.Relname != nil {
+		return *rv.Relname
+	}
+	return strings.Join(names, ", ")
+}
+
+// conjuncts splits a WHERE clause on AND
+func conjuncts(where ast.Node) []ast.Node {
+	if !isSet(where) {
+		return nil
+	}
+	if b, ok := where.(*ast.BoolExpr); ok && b.Boolop == ast.BoolExprTypeAnd && b.Args != nil {
+		var list []ast.Node
+		for _, arg := range b.Args.Items {
+			list = append(list, conjuncts(arg)...)
+		}
+		return list
+	}
+	return []ast.Node{where}
+}
+
+// checkOffsetPagination reports OFFSETs that aren't constants. Paging through
+// a large table with OFFSET reads and discards every skipped row.
+func checkOffsetPagination(_ *catalog.Catalog, _ config.Engine, raw *ast.RawStmt) []string {
+	var msgs []string
+	for _, n := range searchSelects(raw) {
+		if !isSet(n.LimitOffset) {
+			continue
+		}
+		if _, constant := n.LimitOffset.(*ast.A_Const); constant {
+			continue
+		}
+		msgs = append(msgs, "OFFSET pagination reads every skipped row, use keyset pagination on large tables")
+	}
+	return msgs
+}
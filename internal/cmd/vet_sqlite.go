@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/debug"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+	"github.com/sqlc-dev/sqlc/internal/vet"
+)
+
+type sqliteExplainer struct {
+	*sql.DB
+}
+
+// SQLite doesn't need parameter values to plan a query, so every parameter is
+// bound to NULL.
+func (se *sqliteExplainer) Explain(ctx context.Context, query string, args ...*plugin.Parameter) (*vetEngineOutput, error) {
+	eQuery := "EXPLAIN QUERY PLAN " + query
+	eArgs := make([]any, len(args))
+	rows, err := se.QueryContext(ctx, eQuery, eArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var nodes []*vet.SQLiteExplain_Node
+	for rows.Next() {
+		var id, parent, notused int32
+		var detail string
+		if err := rows.Scan(&id, &parent, &notused, &detail); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, parseSQLiteExplainDetail(id, parent, detail))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if debug.Debug.DumpExplain {
+		fmt.Println(eQuery, "with args", eArgs)
+		for _, n := range nodes {
+			fmt.Println(n.Id, n.Parent, n.Detail)
+		}
+	}
+	return &vetEngineOutput{SQLite: &vet.SQLite{Explain: sqliteExplainTree(nodes)}}, nil
+}
+
+// sqliteExplainTree nests each node under its parent. SQLite always lists a
+// parent before its children.
+func sqliteExplainTree(nodes []*vet.SQLiteExplain_Node) *vet.SQLiteExplain {
+	explain := &vet.SQLiteExplain{}
+	byID := map[int32]*vet.SQLiteExplain_Node{}
+	for _, n := range nodes {
+		explain.Nodes = append(explain.Nodes, n.CloneVT())
+
+		byID[n.Id] = n
+		if p, ok := byID[n.Parent]; ok && n.Parent != 0 {
+			p.Children = append(p.Children, n)
+		} else {
+			explain.Plan = append(explain.Plan, n)
+		}
+	}
+	return explain
+}
+
+// parseSQLiteExplainDetail parses the detail column of EXPLAIN QUERY PLAN.
+// The format isn't stable across SQLite versions (https://www.sqlite.org/eqp.html),
+// so anything that isn't recognized is kept as the operation.
+//
+//	SCAN users
+//	SCAN users USING COVERING INDEX users_email
+//	SEARCH users USING INDEX users_email (email=?)
+//	SEARCH users USING INTEGER PRIMARY KEY (rowid=?)
+//	USE TEMP B-TREE FOR ORDER BY
+func parseSQLiteExplainDetail(id, parent int32, detail string) *vet.SQLiteExplain_Node {
+	n := &vet.SQLiteExplain_Node{
+		Id:     id,
+		Parent: parent,
+		Detail: detail,
+	}
+
+	switch {
+	case detail == "SCAN CONSTANT ROW":
+		n.Operation = detail
+
+	case strings.HasPrefix(detail, "SCAN "), strings.HasPrefix(detail, "SEARCH "):
+		op, rest, _ := strings.Cut(detail, " ")
+		n.Operation = op
+
+		// Versions before 3.36.0 print "SCAN TABLE users"
+		rest = strings.TrimPrefix(rest, "TABLE ")
+
+		if i := strings.Index(rest, " ("); i >= 0 && strings.HasSuffix(rest, ")") {
+			n.Constraint = rest[i+2 : len(rest)-1]
+			rest = rest[:i]
+		}
+
+		rest, using, _ := strings.Cut(rest, " USING ")
+		n.Table, n.Alias, _ = strings.Cut(rest, " AS ")
+
+		switch {
+		case using == "":
+		case using == "INTEGER PRIMARY KEY", strings.HasPrefix(using, "ROWID"):
+			n.IntegerPrimaryKey = true
+		default:
+			if s, ok := strings.CutPrefix(using, "AUTOMATIC "); ok {
+				n.AutomaticIndex = true
+				using = s
+			}
+			using = strings.TrimPrefix(using, "PARTIAL ")
+			if s, ok := strings.CutPrefix(using, "COVERING "); ok {
+				n.CoveringIndex = true
+				using = s
+			}
+			using = strings.TrimPrefix(using, "INDEX")
+			n.Index = strings.TrimSpace(using)
+		}
+
+	case strings.HasPrefix(detail, "USE TEMP B-TREE"):
+		n.Operation = "USE TEMP B-TREE"
+		_, n.TempBTreeFor, _ = strings.Cut(detail, " FOR ")
+
+	case strings.HasPrefix(detail, "MATERIALIZE "), strings.HasPrefix(detail, "CO-ROUTINE "):
+		n.Operation, n.Table, _ = strings.Cut(detail, " ")
+
+	default:
+		n.Operation = detail
+	}
+
+	return n
+}
@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"slices"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/vet"
+)
+
+// vetTables returns the tables referenced by a query and the columns used in
+// its WHERE clauses. Relations that aren't in the catalog, like CTEs, are
+// left out.
+func vetTables(c *catalog.Catalog, raw *ast.RawStmt) ([]*vet.Table, []*vet.ColumnRef) {
+	if c == nil || raw == nil {
+		return nil, nil
+	}
+
+	tables, named := queryTables(c, raw)
+
+	var refs []*vet.ColumnRef
+	for _, where := range astutils.Search(raw, func(node ast.Node) bool {
+		switch node.(type) {
+		case *ast.SelectStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+			return true
+		}
+		return false
+	}).Items {
+		var clause ast.Node
+		switch n := where.(type) {
+		case *ast.SelectStmt:
+			clause = n.WhereClause
+		case *ast.UpdateStmt:
+			clause = n.WhereClause
+		case *ast.DeleteStmt:
+			clause = n.WhereClause
+		}
+		if clause == nil {
+			continue
+		}
+		for _, node := range astutils.Search(clause, func(node ast.Node) bool {
+			_, ok := node.(*ast.ColumnRef)
+			return ok
+		}).Items {
+			ref := resolveColumnRef(tables, named, node.(*ast.ColumnRef))
+			if ref != nil && !slices.ContainsFunc(refs, func(other *vet.ColumnRef) bool {
+				return other.Schema == ref.Schema && other.Table == ref.Table && other.Name == ref.Name
+			}) {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return tables, refs
+}
+
+// queryTables returns the catalog tables referenced in a node, and the tables
+// by the name or alias they're referenced by
+func queryTables(c *catalog.Catalog, node ast.Node) ([]*vet.Table, map[string]*vet.Table) {
+	var tables []*vet.Table
+	named := map[string]*vet.Table{}
+	for _, node := range astutils.Search(node, func(node ast.Node) bool {
+		_, ok := node.(*ast.RangeVar)
+		return ok
+	}).Items {
+		rv := node.(*ast.RangeVar)
+		if rv.Relname == nil {
+			continue
+		}
+		rel := &ast.TableName{Name: *rv.Relname}
+		if rv.Schemaname != nil {
+			rel.Schema = *rv.Schemaname
+		}
+		t, err := c.GetTable(rel)
+		if err != nil {
+			continue
+		}
+		vt := vetTable(c, &t)
+		if i := slices.IndexFunc(tables, func(other *vet.Table) bool {
+			return other.Schema == vt.Schema && other.Name == vt.Name
+		}); i >= 0 {
+			vt = tables[i]
+		} else {
+			tables = append(tables, vt)
+		}
+		named[vt.Name] = vt
+		if rv.Alias != nil && rv.Alias.Aliasname != nil {
+			named[*rv.Alias.Aliasname] = vt
+		}
+	}
+	return tables, named
+}
+
+func vetTable(c *catalog.Catalog, t *catalog.Table) *vet.Table {
+	vt := &vet.Table{
+		Schema: t.Rel.Schema,
+		Name:   t.Rel.Name,
+	}
+	if vt.Schema == "" {
+		vt.Schema = c.DefaultSchema
+	}
+	vt.Comment = t.Comment
+	for _, col := range t.Columns {
+		// Hidden columns, like the rowid of SQLite tables, aren't part of the
+		// table's definition
+		if col.IsHidden {
+			continue
+		}
+		vt.Columns = append(vt.Columns, col.Name)
+		vt.ColumnDefs = append(vt.ColumnDefs, &vet.Column{
+			Name:    col.Name,
+			Type:    vetType(col.Type.Schema, col.Type.Name),
+			NotNull: col.IsNotNull,
+			IsArray: col.IsArray,
+			Comment: col.Comment,
+			Schema:  vt.Schema,
+			Table:   vt.Name,
+		})
+	}
+	for _, idx := range t.Indexes {
+		vt.Indexes = append(vt.Indexes, &vet.Index{
+			Name:    idx.Name,
+			Columns: idx.Columns,
+			Unique:  idx.Unique,
+			Primary: idx.Primary,
+		})
+	}
+	for _, fk := range t.ForeignKeys {
+		ref := &vet.ForeignKey{
+			Name:       fk.Name,
+			Columns:    fk.Columns,
+			RefSchema:  fk.RefTable.Schema,
+			RefTable:   fk.RefTable.Name,
+			RefColumns: fk.RefColumns,
+		}
+		if ref.RefSchema == "" {
+			ref.RefSchema = c.DefaultSchema
+		}
+		vt.ForeignKeys = append(vt.ForeignKeys, ref)
+	}
+	return vt
+}
+
+// vetCatalog returns the user-defined schemas of a catalog
+func vetCatalog(c *catalog.Catalog) *vet.Catalog {
+	vc := &vet.Catalog{DefaultSchema: c.DefaultSchema}
+	for _, s := range c.Schemas {
+		if s.Name == "pg_catalog" || s.Name == "information_schema" {
+			continue
+		}
+		vs := &vet.Schema{Name: s.Name, Comment: s.Comment}
+		for _, t := range s.Tables {
+			vs.Tables = append(vs.Tables, vetTable(c, t))
+		}
+		for _, typ := range s.Types {
+			if enum, ok := typ.(*catalog.Enum); ok {
+				vs.Enums = append(vs.Enums, &vet.Enum{
+					Name:    enum.Name,
+					Values:  enum.Vals,
+					Comment: enum.Comment,
+				})
+			}
+		}
+		vc.Schemas = append(vc.Schemas, vs)
+	}
+	return vc
+}
+
+// vetType names a data type the way it's written in a schema. Built-in types
+// aren't qualified.
+func vetType(schema, name string) string {
+	if schema == "" || schema == "pg_catalog" {
+		return name
+	}
+	return schema + "." + name
+}
+
+// resolveColumnRef finds the table a column reference belongs to. Qualified
+// references are looked up by table name or alias, unqualified references
+// match when exactly one of the tables has a column with that name.
+func resolveColumnRef(tables []*vet.Table, named map[string]*vet.Table, ref *ast.ColumnRef) *vet.ColumnRef {
+	var parts []string
+	if ref.Fields != nil {
+		for _, item := range ref.Fields.Items {
+			s, ok := item.(*ast.String)
+			if !ok {
+				// Stars and other fields can't be resolved
+				return nil
+			}
+			parts = append(parts, s.Str)
+		}
+	} else if ref.Name != "" {
+		parts = []string{ref.Name}
+	}
+	switch len(parts) {
+	case 0:
+		return nil
+	case 1:
+		var found *vet.Table
+		for _, t := range tables {
+			if slices.Contains(t.Columns, parts[0]) {
+				if found != nil {
+					return nil
+				}
+				found = t
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		return &vet.ColumnRef{Schema: found.Schema, Table: found.Name, Name: parts[0]}
+	default:
+		name := parts[len(parts)-1]
+		t, ok := named[parts[len(parts)-2]]
+		if !ok || !slices.Contains(t.Columns, name) {
+			return nil
+		}
+		return &vet.ColumnRef{Schema: t.Schema, Table: t.Name, Name: name}
+	}
+}
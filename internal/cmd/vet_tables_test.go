@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+func TestVetTableSkipsHiddenColumns(t *testing.T) {
+	c := catalog.New("main")
+	table := &catalog.Table{
+		Rel: &ast.TableName{Name: "authors"},
+		Columns: []*catalog.Column{
+			{Name: "id", Type: ast.TypeName{Name: "integer"}, IsNotNull: true},
+			{Name: "name", Type: ast.TypeName{Name: "text"}},
+			{Name: "rowid", Type: ast.TypeName{Name: "integer"}, IsNotNull: true, IsHidden: true},
+		},
+	}
+	vt := vetTable(c, table)
+	if want := []string{"id", "name"}; !slices.Equal(vt.Columns, want) {
+		t.Errorf("columns: got %v, want %v", vt.Columns, want)
+	}
+	var defs []string
+	for _, col := range vt.ColumnDefs {
+		defs = append(defs, col.Name)
+	}
+	if want := []string{"id", "name"}; !slices.Equal(defs, want) {
+		t.Errorf("column definitions: got %v, want %v", defs, want)
+	}
+}
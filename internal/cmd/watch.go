@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+// How often watched files are checked for changes
+const watchInterval = 500 * time.Millisecond
+
+// fileStamp is what's compared to notice a changed file
+type fileStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+// Watch generates code like Generate, then again each time the configuration
+// file or one of the schema and query files changes, until ctx is done. Only
+// output pairs that read a changed file are compiled again; a change to the
+// configuration file regenerates everything. Errors are printed and watching
+// continues. The output of the pairs that succeeded is written, and pairs
+// that failed are retried on the next change.
+func Watch(ctx context.Context, dir, filename string, o *Options, write func(map[string]string) error) error {
+	e := o.Env
+	stderr := o.Stderr
+
+	var configPath string
+	var conf *config.Config
+	var pairs []OutputPair
+	var pairFiles [][]string
+	// Pairs that need to be generated on the next run
+	pending := map[int]bool{}
+	seen := map[string]fileStamp{}
+	reload := true
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		if reload {
+			reload = false
+			pairs = nil
+			pending = map[int]bool{}
+			path, c, err := o.ReadConfig(dir, filename)
+			if configPath == "" && err != nil {
+				return err
+			}
+			if path != "" {
+				configPath = path
+			}
+			if err == nil {
+				if err = config.Validate(c); err == nil {
+					err = e.Validate(c)
+				}
+				if err != nil {
+					fmt.Fprintf(stderr, "error validating %s: %s\n", filepath.Base(configPath), err)
+				}
+			}
+			if err == nil && c.Cloud.Project != "" && e.Remote && !e.NoRemote {
+				fmt.Fprintln(stderr, "error: remote generation can't be watched")
+				return errors.New("remote generation can't be watched")
+			}
+			if err == nil {
+				conf = c
+				pairs = (&generator{}).Pairs(ctx, conf)
+				for i := range pairs {
+					pending[i] = true
+				}
+			}
+		}
+
+		pairFiles = make([][]string, len(pairs))
+		for i, pair := range pairs {
+			pairFiles[i] = watchedFiles(dir, pair)
+		}
+		seen = stampFiles(configPath, pairFiles)
+
+		if len(pending) > 0 {
+			g := &generator{
+				dir:    dir,
+				output: map[string]string{},
+				store:  newGenerateCache(e.Debug),
+			}
+			run := &pairFilter{generator: g, pairs: pairs, keep: pending}
+			err := processQuerySets(ctx, run, conf, dir, o)
+			var perr *pairsError
+			if err == nil || errors.As(err, &perr) {
+				// The output of the pairs that succeeded is written even
+				// when others failed
+				if err := write(g.output); err != nil {
+					fmt.Fprintf(stderr, "error writing files: %s\n", err)
+				} else {
+					pending = run.failed(perr)
+				}
+			}
+		}
+
+		// Wait for a change. Pairs that failed stay pending until then.
+		for !reload {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+			if configPath != "" && changed(seen, configPath) {
+				reload = true
+				break
+			}
+			touched := false
+			for i, files := range pairFiles {
+				if touchedFiles(dir, pairs[i], files, seen) {
+					pending[i] = true
+					touched = true
+				}
+			}
+			if touched {
+				break
+			}
+		}
+	}
+}
+
+// pairFilter runs a generator over the pending output pairs only
+type pairFilter struct {
+	*generator
+	pairs []OutputPair
+	keep  map[int]bool
+	// Indexes into pairs of the pairs that were run
+	picked []int
+}
+
+func (f *pairFilter) Pairs(ctx context.Context, conf *config.Config) []OutputPair {
+	var pairs []OutputPair
+	f.picked = nil
+	for i, pair := range f.pairs {
+		if f.keep[i] {
+			pairs = append(pairs, pair)
+			f.picked = append(f.picked, i)
+		}
+	}
+	return pairs
+}
+
+// failed returns the pairs of a run that failed, which stay pending
+func (f *pairFilter) failed(perr *pairsError) map[int]bool {
+	pending := map[int]bool{}
+	if perr == nil {
+		return pending
+	}
+	for _, i := range perr.failed {
+		pending[f.picked[i]] = true
+	}
+	return pending
+}
+
+// watchedFiles returns the schema and query files of a pair. Paths that
+// can't be read are left out; compiling the pair reports them.
+func watchedFiles(dir string, pair OutputPair) []string {
+	var files []string
+	for _, paths := range []config.Paths{pair.Schema, pair.Queries} {
+		for _, path := range paths {
+			matches, err := sqlpath.Glob([]string{filepath.Join(dir, path)})
+			if err != nil {
+				continue
+			}
+			files = append(files, matches...)
+		}
+	}
+	return files
+}
+
+// touchedFiles reports whether any of the files of a pair changed, or
+// whether files were added to or removed from its paths
+func touchedFiles(dir string, pair OutputPair, files []string, seen map[string]fileStamp) bool {
+	current := watchedFiles(dir, pair)
+	if len(current) != len(files) {
+		return true
+	}
+	for i := range current {
+		if current[i] != files[i] || changed(seen, current[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func stampFiles(configPath string, pairFiles [][]string) map[string]fileStamp {
+	stamps := map[string]fileStamp{}
+	add := func(file string) {
+		if fi, err := os.Stat(file); err == nil {
+			stamps[file] = fileStamp{size: fi.Size(), modTime: fi.ModTime()}
+		}
+	}
+	if configPath != "" {
+		add(configPath)
+	}
+	for _, files := range pairFiles {
+		for _, file := range files {
+			add(file)
+		}
+	}
+	return stamps
+}
+
+func changed(seen map[string]fileStamp, file string) bool {
+	stamp, ok := seen[file]
+	fi, err := os.Stat(file)
+	if err != nil {
+		return ok
+	}
+	return !ok || stamp.size != fi.Size() || !stamp.modTime.Equal(fi.ModTime())
+}
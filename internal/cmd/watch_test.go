@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sqlc-dev/sqlc/internal/opts"
+)
+
+const watchConfig = `
+version: "2"
+sql:
+  - engine: postgresql
+    schema: schema.sql
+    queries: authors.sql
+    gen:
+      go:
+        package: authors
+        out: authors
+  - engine: postgresql
+    schema: schema.sql
+    queries: books.sql
+    gen:
+      go:
+        package: books
+        out: books
+`
+
+const watchSchema = `
+CREATE TABLE authors (id BIGSERIAL PRIMARY KEY, name TEXT NOT NULL);
+CREATE TABLE books (id BIGSERIAL PRIMARY KEY, title TEXT NOT NULL);
+`
+
+// outputDirs returns the output directories a run wrote files to
+func outputDirs(dir string, output map[string]string) []string {
+	var dirs []string
+	for file := range output {
+		rel, err := filepath.Rel(dir, filepath.Dir(file))
+		if err == nil && !slices.Contains(dirs, rel) {
+			dirs = append(dirs, rel)
+		}
+	}
+	slices.Sort(dirs)
+	return dirs
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	writeCacheFile(t, dir, "sqlc.yaml", watchConfig)
+	writeCacheFile(t, dir, "schema.sql", watchSchema)
+	writeCacheFile(t, dir, "authors.sql", "-- name: ListAuthors :many\nSELECT * FROM authors;\n")
+	writeCacheFile(t, dir, "books.sql", "-- name: ListBooks :many\nSELECT * FROM book;\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	writes := make(chan map[string]string)
+	done := make(chan error)
+	var stderr bytes.Buffer
+	o := &Options{
+		Env:    Env{Debug: opts.DebugFromString("generatecache=0")},
+		Stderr: &stderr,
+	}
+	go func() {
+		done <- Watch(ctx, dir, "", o, func(output map[string]string) error {
+			select {
+			case writes <- output:
+			case <-ctx.Done():
+			}
+			return nil
+		})
+	}()
+
+	next := func(t *testing.T, want ...string) {
+		t.Helper()
+		select {
+		case output := <-writes:
+			if got := outputDirs(dir, output); !slices.Equal(got, want) {
+				t.Errorf("wrote %v, want %v", got, want)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for %v", want)
+		}
+	}
+
+	// The broken package doesn't hold back the output of the other one
+	next(t, "authors")
+
+	// Fixing the broken package only generates that package
+	writeCacheFile(t, dir, "books.sql", "-- name: ListBooks :many\nSELECT * FROM books;\n")
+	next(t, "books")
+
+	// Once every package succeeded, only changed packages are generated
+	writeCacheFile(t, dir, "authors.sql", "-- name: ListAuthors :many\nSELECT id, name FROM authors;\n")
+	next(t, "authors")
+
+	// A schema change regenerates every package that reads it
+	writeCacheFile(t, dir, "schema.sql", watchSchema+"CREATE TABLE tags (id BIGSERIAL PRIMARY KEY);\n")
+	next(t, "authors", "books")
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
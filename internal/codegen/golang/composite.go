@@ -0,0 +1,144 @@
+package golang
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// A CompositeType is the struct generated for a PostgreSQL composite type
+type CompositeType struct {
+	Name    string
+	Comment string
+	Fields  []Field
+	// The name of the type in the database and of the enums and composite
+	// types its attributes depend on
+	Type     *plugin.Identifier
+	Requires []*plugin.Identifier
+}
+
+// emitCompositeTypes reports whether composite types are generated as structs.
+// pgx/v4 has no way to register them, so they stay strings there.
+func emitCompositeTypes(options *opts.Options) bool {
+	return options.EmitCompositeTypes && parseDriver(options.SqlPackage) != opts.SQLDriverPGXV4
+}
+
+func compositeTypeName(req *plugin.GenerateRequest, options *opts.Options, schema, name string) string {
+	if schema == req.Catalog.DefaultSchema {
+		return StructName(name, options)
+	}
+	return StructName(schema+"_"+name, options)
+}
+
+func buildCompositeTypes(req *plugin.GenerateRequest, options *opts.Options) []CompositeType {
+	if req.Settings.Engine != "postgresql" || !emitCompositeTypes(options) {
+		return nil
+	}
+	var cts []CompositeType
+	for _, schema := range req.Catalog.Schemas {
+		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
+			continue
+		}
+		for _, ct := range schema.CompositeTypes {
+			c := CompositeType{
+				Name:    compositeTypeName(req, options, schema.Name, ct.Name),
+				Comment: ct.Comment,
+				Type:    &plugin.Identifier{Schema: schema.Name, Name: ct.Name},
+			}
+			for _, column := range ct.Columns {
+				tags := map[string]string{}
+				if options.EmitDbTags {
+					tags["db"] = column.Name
+				}
+				if options.EmitJsonTags {
+					tags["json"] = JSONTagName(column.Name, options)
+				}
+				c.Fields = append(c.Fields, Field{
+					Name:   StructName(column.Name, options),
+					DBName: column.Name,
+					Type:   goType(req, options, column),
+					Tags:   tags,
+					Column: column,
+				})
+				if dep := userDefinedType(req, column.Type); dep != nil {
+					c.Requires = append(c.Requires, dep)
+				}
+			}
+			cts = append(cts, c)
+		}
+	}
+	if len(cts) > 0 {
+		sort.Slice(cts, func(i, j int) bool { return cts[i].Name < cts[j].Name })
+	}
+	return cts
+}
+
+// userDefinedType returns the schema and name of an enum or composite type
+func userDefinedType(req *plugin.GenerateRequest, typ *plugin.Identifier) *plugin.Identifier {
+	rel, err := parseIdentifierString(sdk.DataType(typ))
+	if err != nil {
+		return nil
+	}
+	if rel.Schema == "" {
+		rel.Schema = req.Catalog.DefaultSchema
+	}
+	for _, schema := range req.Catalog.Schemas {
+		if schema.Name != rel.Schema {
+			continue
+		}
+		for _, enum := range schema.Enums {
+			if enum.Name == rel.Name {
+				return &plugin.Identifier{Schema: schema.Name, Name: enum.Name}
+			}
+		}
+		for _, ct := range schema.CompositeTypes {
+			if ct.Name == rel.Name {
+				return &plugin.Identifier{Schema: schema.Name, Name: ct.Name}
+			}
+		}
+	}
+	return nil
+}
+
+// RegisteredTypes lists the types pgx has to load before the composite types
+// can be used, dependencies first, each followed by its array type. The names
+// are Go literals of pgx.Identifier.
+func (t *tmplCtx) RegisteredTypes() []string {
+	byName := make(map[string]CompositeType, len(t.CompositeTypes))
+	for _, ct := range t.CompositeTypes {
+		byName[ct.Type.Schema+"."+ct.Type.Name] = ct
+	}
+	var names []string
+	seen := map[string]bool{}
+	var visit func(id *plugin.Identifier)
+	visit = func(id *plugin.Identifier) {
+		key := id.Schema + "." + id.Name
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		if ct, ok := byName[key]; ok {
+			for _, dep := range ct.Requires {
+				visit(dep)
+			}
+		}
+		for _, name := range []string{id.Name, "_" + id.Name} {
+			parts := []string{name}
+			if id.Schema != t.DefaultSchema {
+				parts = []string{id.Schema, name}
+			}
+			for i := range parts {
+				parts[i] = strconv.Quote(parts[i])
+			}
+			names = append(names, "{"+strings.Join(parts, ", ")+"}")
+		}
+	}
+	for _, ct := range t.CompositeTypes {
+		visit(ct.Type)
+	}
+	return names
+}
@@ -0,0 +1,125 @@
+package golang
+
+import (
+	"sort"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// A Domain is the named type generated for a PostgreSQL domain with
+// emit_domain_types
+type Domain struct {
+	Name    string
+	Type    string
+	Comment string
+}
+
+// Domains are only generated for base types that keep working when they're
+// wrapped in a named type. database/sql and pgx can't scan into named struct
+// types, which don't have the methods of the struct.
+var domainBaseTypes = map[string]struct{}{
+	"string":  {},
+	"bool":    {},
+	"int16":   {},
+	"int32":   {},
+	"int64":   {},
+	"float32": {},
+	"float64": {},
+	"[]byte":  {},
+}
+
+func findDomain(req *plugin.GenerateRequest, typ *plugin.Identifier) (*plugin.Schema, *plugin.Domain) {
+	schemaName := typ.Schema
+	if schemaName == "" {
+		schemaName = req.Catalog.DefaultSchema
+	}
+	for _, schema := range req.Catalog.Schemas {
+		if schema.Name != schemaName {
+			continue
+		}
+		for _, d := range schema.Domains {
+			if d.Name == typ.Name {
+				return schema, d
+			}
+		}
+	}
+	return nil, nil
+}
+
+// domainType returns the name of the type generated for the domain of col, or
+// false if the domain doesn't get one
+func domainType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) (string, bool) {
+	if !options.EmitDomainTypes || col.Domain == nil {
+		return "", false
+	}
+	schema, d := findDomain(req, col.Domain)
+	if d == nil || d.IsArray {
+		return "", false
+	}
+	if _, ok := domainBaseTypes[domainBaseType(req, options, d)]; !ok {
+		return "", false
+	}
+	name := d.Name
+	if schema.Name != req.Catalog.DefaultSchema {
+		name = schema.Name + "_" + d.Name
+	}
+	return StructName(name, options), true
+}
+
+func domainBaseType(req *plugin.GenerateRequest, options *opts.Options, d *plugin.Domain) string {
+	return goInnerType(req, options, &plugin.Column{
+		Type:    d.BaseType,
+		NotNull: true,
+		Length:  -1,
+	})
+}
+
+func buildDomains(req *plugin.GenerateRequest, options *opts.Options) []Domain {
+	if !options.EmitDomainTypes {
+		return nil
+	}
+	var domains []Domain
+	for _, schema := range req.Catalog.Schemas {
+		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
+			continue
+		}
+		for _, d := range schema.Domains {
+			name, ok := domainType(req, options, &plugin.Column{
+				Domain: &plugin.Identifier{Schema: schema.Name, Name: d.Name},
+			})
+			if !ok {
+				continue
+			}
+			domains = append(domains, Domain{
+				Name:    name,
+				Type:    domainBaseType(req, options, d),
+				Comment: d.Comment,
+			})
+		}
+	}
+	if len(domains) > 0 {
+		sort.Slice(domains, func(i, j int) bool { return domains[i].Name < domains[j].Name })
+	}
+	return domains
+}
+
+// domainOverride returns the Go type of the first override with a db_type
+// matching the domain of col
+func domainOverride(options *opts.Options, col *plugin.Column, notNull bool) (string, bool) {
+	if col.Domain == nil {
+		return "", false
+	}
+	domain := sdk.DataType(col.Domain)
+	for _, override := range options.Overrides {
+		oride := override.ShimOverride
+		if oride.GoType.TypeName == "" {
+			continue
+		}
+		if oride.DbType != "" && oride.DbType == domain && oride.Nullable != notNull && oride.Unsigned == col.Unsigned {
+			return oride.GoType.TypeName, true
+		}
+	}
+	return "", false
+}
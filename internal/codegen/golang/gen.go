@@ -24,6 +24,12 @@ type tmplCtx struct {
 	Structs     []Struct
 	GoQueries   []Query
 	SqlcVersion string
+	Engine      string
+
+	// CompositeTypes and Domains are only set for PostgreSQL
+	CompositeTypes []CompositeType
+	Domains        []Domain
+	DefaultSchema  string
 
 	// TODO: Race conditions
 	SourceName string
@@ -47,6 +53,12 @@ func (t *tmplCtx) OutputQuery(sourceName string) bool {
 	return t.SourceName == sourceName
 }
 
+// RetryTx reports whether the generated transaction helpers can tell which
+// errors are worth retrying. SQLite transactions are never retried.
+func (t *tmplCtx) RetryTx() bool {
+	return t.Engine == "postgresql" || t.Engine == "mysql"
+}
+
 func (t *tmplCtx) codegenDbarg() string {
 	if t.EmitMethodsWithDBArgument {
 		return "db DBTX, "
@@ -73,7 +85,7 @@ func (t *tmplCtx) codegenQueryMethod(q Query) string {
 		}
 		return db + ".QueryRowContext"
 
-	case ":many":
+	case ":many", ":iter":
 		if t.EmitPreparedQueries {
 			return "q.query"
 		}
@@ -91,7 +103,7 @@ func (t *tmplCtx) codegenQueryRetval(q Query) (string, error) {
 	switch q.Cmd {
 	case ":one":
 		return "row :=", nil
-	case ":many":
+	case ":many", ":iter":
 		return "rows, err :=", nil
 	case ":exec":
 		return "_, err :=", nil
@@ -104,6 +116,34 @@ func (t *tmplCtx) codegenQueryRetval(q Query) (string, error) {
 	}
 }
 
+// The return values of the generated method for a query
+func (t *tmplCtx) codegenQueryResults(q Query) (string, error) {
+	switch q.Cmd {
+	case metadata.CmdOne:
+		return "(" + q.Ret.DefineType() + ", error)", nil
+	case metadata.CmdMany:
+		if q.Pagination != nil {
+			return "([]" + q.Ret.DefineType() + ", *" + q.Arg.Type() + ", error)", nil
+		}
+		return "([]" + q.Ret.DefineType() + ", error)", nil
+	case metadata.CmdIter:
+		return "iter.Seq2[" + q.Ret.DefineType() + ", error]", nil
+	case metadata.CmdExec:
+		return "error", nil
+	case metadata.CmdExecRows, metadata.CmdExecLastId, metadata.CmdCopyFrom:
+		return "(int64, error)", nil
+	case metadata.CmdExecResult:
+		if t.SQLDriver.IsPGX() {
+			return "(pgconn.CommandTag, error)", nil
+		}
+		return "(sql.Result, error)", nil
+	case metadata.CmdBatchExec, metadata.CmdBatchMany, metadata.CmdBatchOne:
+		return "*" + q.MethodName + "BatchResults", nil
+	default:
+		return "", fmt.Errorf("unhandled q.Cmd case %q", q.Cmd)
+	}
+}
+
 func Generate(ctx context.Context, req *plugin.GenerateRequest) (*plugin.GenerateResponse, error) {
 	options, err := opts.Parse(req)
 	if err != nil {
@@ -116,23 +156,25 @@ func Generate(ctx context.Context, req *plugin.GenerateRequest) (*plugin.Generat
 
 	enums := buildEnums(req, options)
 	structs := buildStructs(req, options)
+	cts := buildCompositeTypes(req, options)
+	domains := buildDomains(req, options)
 	queries, err := buildQueries(req, options, structs)
 	if err != nil {
 		return nil, err
 	}
 
 	if options.OmitUnusedStructs {
-		enums, structs = filterUnusedStructs(enums, structs, queries)
+		enums, structs, cts, domains = filterUnusedStructs(enums, structs, cts, domains, queries)
 	}
 
-	if err := validate(options, enums, structs, queries); err != nil {
+	if err := validate(options, enums, structs, cts, domains, queries); err != nil {
 		return nil, err
 	}
 
-	return generate(req, options, enums, structs, queries)
+	return generate(req, options, enums, structs, cts, domains, queries)
 }
 
-func validate(options *opts.Options, enums []Enum, structs []Struct, queries []Query) error {
+func validate(options *opts.Options, enums []Enum, structs []Struct, cts []CompositeType, domains []Domain, queries []Query) error {
 	enumNames := make(map[string]struct{})
 	for _, enum := range enums {
 		enumNames[enum.Name] = struct{}{}
@@ -145,6 +187,24 @@ func validate(options *opts.Options, enums []Enum, structs []Struct, queries []Q
 		}
 		structNames[struckt.Name] = struct{}{}
 	}
+	for _, ct := range cts {
+		if _, ok := enumNames[ct.Name]; ok {
+			return fmt.Errorf("composite type name conflicts with enum name: %s", ct.Name)
+		}
+		if _, ok := structNames[ct.Name]; ok {
+			return fmt.Errorf("composite type name conflicts with struct name: %s", ct.Name)
+		}
+		structNames[ct.Name] = struct{}{}
+	}
+	for _, d := range domains {
+		if _, ok := enumNames[d.Name]; ok {
+			return fmt.Errorf("domain type name conflicts with enum name: %s", d.Name)
+		}
+		if _, ok := structNames[d.Name]; ok {
+			return fmt.Errorf("domain type name conflicts with struct name: %s", d.Name)
+		}
+		structNames[d.Name] = struct{}{}
+	}
 	if !options.EmitExportedQueries {
 		return nil
 	}
@@ -159,12 +219,14 @@ func validate(options *opts.Options, enums []Enum, structs []Struct, queries []Q
 	return nil
 }
 
-func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum, structs []Struct, queries []Query) (*plugin.GenerateResponse, error) {
+func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum, structs []Struct, cts []CompositeType, domains []Domain, queries []Query) (*plugin.GenerateResponse, error) {
 	i := &importer{
-		Options: options,
-		Queries: queries,
-		Enums:   enums,
-		Structs: structs,
+		Engine:         req.Settings.Engine,
+		Options:        options,
+		Queries:        queries,
+		Enums:          enums,
+		Structs:        structs,
+		CompositeTypes: cts,
 	}
 
 	tctx := tmplCtx{
@@ -184,7 +246,11 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		Package:                   options.Package,
 		Enums:                     enums,
 		Structs:                   structs,
+		CompositeTypes:            cts,
+		Domains:                   domains,
+		DefaultSchema:             req.Catalog.DefaultSchema,
 		SqlcVersion:               req.SqlcVersion,
+		Engine:                    req.Settings.Engine,
 		BuildTags:                 options.BuildTags,
 		OmitSqlcVersion:           options.OmitSqlcVersion,
 	}
@@ -218,6 +284,10 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		"emitPreparedQueries": tctx.codegenEmitPreparedQueries,
 		"queryMethod":         tctx.codegenQueryMethod,
 		"queryRetval":         tctx.codegenQueryRetval,
+		"queryResults":        tctx.codegenQueryResults,
+		"exportedName": func(name string) string {
+			return exportedArgName(name, options)
+		},
 	}
 
 	tmpl := template.Must(
@@ -278,6 +348,14 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 	if options.OutputCopyfromFileName != "" {
 		copyfromFileName = options.OutputCopyfromFileName
 	}
+	mockFileName := "mock_querier.go"
+	if options.OutputMockFileName != "" {
+		mockFileName = options.OutputMockFileName
+	}
+	txFileName := "tx.go"
+	if options.OutputTxFileName != "" {
+		txFileName = options.OutputTxFileName
+	}
 
 	batchFileName := "batch.go"
 	if options.OutputBatchFileName != "" {
@@ -295,6 +373,16 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 			return nil, err
 		}
 	}
+	if options.EmitTxHelpers {
+		if err := execute(txFileName, "txFile"); err != nil {
+			return nil, err
+		}
+	}
+	if options.EmitMock {
+		if err := execute(mockFileName, "mockFile"); err != nil {
+			return nil, err
+		}
+	}
 	if tctx.UsesCopyFrom {
 		if err := execute(copyfromFileName, "copyfromFile"); err != nil {
 			return nil, err
@@ -362,7 +450,7 @@ func checkNoTimesForMySQLCopyFrom(queries []Query) error {
 	return nil
 }
 
-func filterUnusedStructs(enums []Enum, structs []Struct, queries []Query) ([]Enum, []Struct) {
+func filterUnusedStructs(enums []Enum, structs []Struct, cts []CompositeType, domains []Domain, queries []Query) ([]Enum, []Struct, []CompositeType, []Domain) {
 	keepTypes := make(map[string]struct{})
 
 	for _, query := range queries {
@@ -387,6 +475,45 @@ func filterUnusedStructs(enums []Enum, structs []Struct, queries []Query) ([]Enu
 		}
 	}
 
+	keepStructs := make([]Struct, 0, len(structs))
+	for _, st := range structs {
+		if _, ok := keepTypes[st.Name]; ok {
+			keepStructs = append(keepStructs, st)
+			for _, field := range st.Fields {
+				keepTypes[field.Type] = struct{}{}
+			}
+		}
+	}
+
+	// Composite types can be nested, so keep adding the types of the fields
+	// of kept composite types until nothing changes
+	keep := func(typ string) bool {
+		_, ok := keepTypes[typ]
+		_, okPointer := keepTypes["*"+typ]
+		_, okSlice := keepTypes["[]"+typ]
+		return ok || okPointer || okSlice
+	}
+	keepCompositeTypes := make([]CompositeType, 0, len(cts))
+	kept := make(map[string]bool, len(cts))
+	for changed := true; changed; {
+		changed = false
+		for _, ct := range cts {
+			if kept[ct.Name] || !keep(ct.Name) {
+				continue
+			}
+			kept[ct.Name] = true
+			changed = true
+			for _, field := range ct.Fields {
+				keepTypes[field.Type] = struct{}{}
+			}
+		}
+	}
+	for _, ct := range cts {
+		if kept[ct.Name] {
+			keepCompositeTypes = append(keepCompositeTypes, ct)
+		}
+	}
+
 	keepEnums := make([]Enum, 0, len(enums))
 	for _, enum := range enums {
 		_, keep := keepTypes[enum.Name]
@@ -396,12 +523,12 @@ func filterUnusedStructs(enums []Enum, structs []Struct, queries []Query) ([]Enu
 		}
 	}
 
-	keepStructs := make([]Struct, 0, len(structs))
-	for _, st := range structs {
-		if _, ok := keepTypes[st.Name]; ok {
-			keepStructs = append(keepStructs, st)
+	keepDomains := make([]Domain, 0, len(domains))
+	for _, d := range domains {
+		if keep(d.Name) {
+			keepDomains = append(keepDomains, d)
 		}
 	}
 
-	return keepEnums, keepStructs
+	return keepEnums, keepStructs, keepCompositeTypes, keepDomains
 }
@@ -67,6 +67,17 @@ func goInnerType(req *plugin.GenerateRequest, options *opts.Options, col *plugin
 	columnType := sdk.DataType(col.Type)
 	notNull := col.NotNull || col.IsArray
 
+	// Overrides of a domain come before the ones of its base type
+	if typ, ok := domainOverride(options, col, notNull); ok {
+		return typ
+	}
+	if typ, ok := domainType(req, options, col); ok {
+		if notNull {
+			return typ
+		}
+		return "*" + typ
+	}
+
 	// package overrides have a higher precedence
 	for _, override := range options.Overrides {
 		oride := override.ShimOverride
@@ -58,10 +58,13 @@ func mergeImports(imps ...fileImports) [][]ImportSpec {
 }
 
 type importer struct {
+	Engine  string
 	Options *opts.Options
 	Queries []Query
 	Enums   []Enum
 	Structs []Struct
+
+	CompositeTypes []CompositeType
 }
 
 func (i *importer) usesType(typ string) bool {
@@ -72,6 +75,13 @@ func (i *importer) usesType(typ string) bool {
 			}
 		}
 	}
+	for _, ct := range i.CompositeTypes {
+		for _, f := range ct.Fields {
+			if hasPrefixIgnoringSliceAndPointerPrefix(f.Type, typ) {
+				return true
+			}
+		}
+	}
 	return false
 }
 
@@ -97,6 +107,14 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 	if i.Options.OutputCopyfromFileName != "" {
 		copyfromFileName = i.Options.OutputCopyfromFileName
 	}
+	mockFileName := "mock_querier.go"
+	if i.Options.OutputMockFileName != "" {
+		mockFileName = i.Options.OutputMockFileName
+	}
+	txFileName := "tx.go"
+	if i.Options.OutputTxFileName != "" {
+		txFileName = i.Options.OutputTxFileName
+	}
 	batchFileName := "batch.go"
 	if i.Options.OutputBatchFileName != "" {
 		batchFileName = i.Options.OutputBatchFileName
@@ -109,6 +127,10 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 		return mergeImports(i.modelImports())
 	case querierFileName:
 		return mergeImports(i.interfaceImports())
+	case txFileName:
+		return mergeImports(i.txImports())
+	case mockFileName:
+		return mergeImports(i.mockImports())
 	case copyfromFileName:
 		return mergeImports(i.copyfromImports())
 	case batchFileName:
@@ -180,6 +202,9 @@ func buildImports(options *opts.Options, queries []Query, uses func(string) bool
 				std["database/sql"] = struct{}{}
 			}
 		}
+		if q.Cmd == metadata.CmdIter {
+			std["iter"] = struct{}{}
+		}
 	}
 
 	for typeName, pkg := range stdlibTypes {
@@ -271,6 +296,64 @@ func (i *importer) interfaceImports() fileImports {
 	return sortedImports(std, pkg)
 }
 
+func (i *importer) txImports() fileImports {
+	var pkg []ImportSpec
+	std := []ImportSpec{
+		{Path: "context"},
+	}
+
+	sqlpkg := parseDriver(i.Options.SqlPackage)
+	switch sqlpkg {
+	case opts.SQLDriverPGXV4:
+		std = append(std, ImportSpec{Path: "errors"})
+		pkg = append(pkg, ImportSpec{Path: "github.com/jackc/pgconn"})
+		pkg = append(pkg, ImportSpec{Path: "github.com/jackc/pgx/v4"})
+	case opts.SQLDriverPGXV5:
+		std = append(std, ImportSpec{Path: "errors"})
+		pkg = append(pkg, ImportSpec{Path: "github.com/jackc/pgx/v5/pgconn"})
+		pkg = append(pkg, ImportSpec{Path: "github.com/jackc/pgx/v5"})
+	default:
+		std = append(std, ImportSpec{Path: "database/sql"})
+		switch i.Engine {
+		case "postgresql":
+			std = append(std, ImportSpec{Path: "errors"})
+		case "mysql":
+			std = append(std, ImportSpec{Path: "errors"})
+			pkg = append(pkg, ImportSpec{Path: "github.com/go-sql-driver/mysql"})
+		}
+	}
+
+	sort.Slice(std, func(i, j int) bool { return std[i].Path < std[j].Path })
+	sort.Slice(pkg, func(i, j int) bool { return pkg[i].Path < pkg[j].Path })
+	return fileImports{Std: std, Dep: pkg}
+}
+
+func (i *importer) mockImports() fileImports {
+	std, pkg := buildImports(i.Options, i.Queries, func(name string) bool {
+		for _, q := range i.Queries {
+			if q.hasRetType() {
+				if usesBatch([]Query{q}) {
+					continue
+				}
+				if hasPrefixIgnoringSliceAndPointerPrefix(q.Ret.Type(), name) {
+					return true
+				}
+			}
+			for _, f := range q.MethodArgs() {
+				if hasPrefixIgnoringSliceAndPointerPrefix(f.Type, name) {
+					return true
+				}
+			}
+		}
+		return false
+	})
+
+	std["context"] = struct{}{}
+	std["sync"] = struct{}{}
+
+	return sortedImports(std, pkg)
+}
+
 func (i *importer) modelImports() fileImports {
 	std, pkg := buildImports(i.Options, nil, i.usesType)
 
@@ -279,6 +362,22 @@ func (i *importer) modelImports() fileImports {
 		std["database/sql/driver"] = struct{}{}
 	}
 
+	// The Scan and Value methods of composite types for database/sql
+	if len(i.CompositeTypes) > 0 && !parseDriver(i.Options.SqlPackage).IsPGX() {
+		std["database/sql"] = struct{}{}
+		std["database/sql/driver"] = struct{}{}
+		std["fmt"] = struct{}{}
+		std["strings"] = struct{}{}
+		std["time"] = struct{}{}
+		for _, ct := range i.CompositeTypes {
+			for _, f := range ct.Fields {
+				if strings.HasPrefix(f.Type, "[]") && f.Type != "[]byte" {
+					pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
+				}
+			}
+		}
+	}
+
 	return sortedImports(std, pkg)
 }
 
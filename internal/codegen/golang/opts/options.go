@@ -11,6 +11,8 @@ import (
 
 type Options struct {
 	EmitInterface               bool              `json:"emit_interface" yaml:"emit_interface"`
+	EmitMock                    bool              `json:"emit_mock,omitempty" yaml:"emit_mock"`
+	EmitTxHelpers               bool              `json:"emit_tx_helpers,omitempty" yaml:"emit_tx_helpers"`
 	EmitJsonTags                bool              `json:"emit_json_tags" yaml:"emit_json_tags"`
 	JsonTagsIdUppercase         bool              `json:"json_tags_id_uppercase" yaml:"json_tags_id_uppercase"`
 	EmitDbTags                  bool              `json:"emit_db_tags" yaml:"emit_db_tags"`
@@ -24,6 +26,8 @@ type Options struct {
 	EmitPointersForNullTypes    bool              `json:"emit_pointers_for_null_types" yaml:"emit_pointers_for_null_types"`
 	EmitEnumValidMethod         bool              `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
 	EmitAllEnumValues           bool              `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
+	EmitDomainTypes             bool              `json:"emit_domain_types,omitempty" yaml:"emit_domain_types"`
+	EmitCompositeTypes          bool              `json:"emit_composite_types,omitempty" yaml:"emit_composite_types"`
 	EmitSqlAsComment            bool              `json:"emit_sql_as_comment,omitempty" yaml:"emit_sql_as_comment"`
 	JsonTagsCaseStyle           string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	Package                     string            `json:"package" yaml:"package"`
@@ -37,6 +41,8 @@ type Options struct {
 	OutputModelsFileName        string            `json:"output_models_file_name,omitempty" yaml:"output_models_file_name"`
 	OutputQuerierFileName       string            `json:"output_querier_file_name,omitempty" yaml:"output_querier_file_name"`
 	OutputCopyfromFileName      string            `json:"output_copyfrom_file_name,omitempty" yaml:"output_copyfrom_file_name"`
+	OutputMockFileName          string            `json:"output_mock_file_name,omitempty" yaml:"output_mock_file_name"`
+	OutputTxFileName            string            `json:"output_tx_file_name,omitempty" yaml:"output_tx_file_name"`
 	OutputFilesSuffix           string            `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
 	InflectionExcludeTableNames []string          `json:"inflection_exclude_table_names,omitempty" yaml:"inflection_exclude_table_names"`
 	QueryParameterLimit         *int32            `json:"query_parameter_limit,omitempty" yaml:"query_parameter_limit"`
@@ -147,6 +153,12 @@ func ValidateOpts(opts *Options) error {
 	if opts.EmitMethodsWithDbArgument && opts.EmitPreparedQueries {
 		return fmt.Errorf("invalid options: emit_methods_with_db_argument and emit_prepared_queries options are mutually exclusive")
 	}
+	if opts.EmitTxHelpers && opts.EmitMethodsWithDbArgument {
+		return fmt.Errorf("invalid options: emit_tx_helpers and emit_methods_with_db_argument options are mutually exclusive")
+	}
+	if opts.EmitMock && !opts.EmitInterface {
+		return fmt.Errorf("invalid options: emit_mock requires emit_interface")
+	}
 	if *opts.QueryParameterLimit < 0 {
 		return fmt.Errorf("invalid options: query parameter limit must not be negative")
 	}
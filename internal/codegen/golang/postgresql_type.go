@@ -587,6 +587,12 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 
 			for _, ct := range schema.CompositeTypes {
 				if rel.Name == ct.Name && rel.Schema == schema.Name {
+					if emitCompositeTypes(options) {
+						if notNull {
+							return compositeTypeName(req, options, schema.Name, ct.Name)
+						}
+						return "*" + compositeTypeName(req, options, schema.Name, ct.Name)
+					}
 					if notNull {
 						return "string"
 					}
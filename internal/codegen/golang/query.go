@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
 	"github.com/sqlc-dev/sqlc/internal/metadata"
 	"github.com/sqlc-dev/sqlc/internal/plugin"
 )
@@ -267,10 +268,32 @@ type Query struct {
 	Arg          QueryValue
 	// Used for :copyfrom
 	Table *plugin.Identifier
+	// Used for sqlc.paginate
+	Pagination *Pagination
+}
+
+// Pagination describes how to build the parameters for the next page of a
+// query that uses sqlc.paginate
+type Pagination struct {
+	// The params struct field holding the page size
+	PageSize string
+	Cursor   []PaginationField
+}
+
+type PaginationField struct {
+	// The params struct field holding the cursor value
+	Arg string
+	// The result field the cursor value is read from, empty if the query
+	// returns a single column
+	Ret string
+	// How the nullable params field is set from the result: assigned if
+	// empty, "pointer" to assign its address and "scan" to call its Scan
+	// method, which database/sql and pgtype null types implement
+	Set string
 }
 
 func (q Query) hasRetType() bool {
-	scanned := q.Cmd == metadata.CmdOne || q.Cmd == metadata.CmdMany ||
+	scanned := q.Cmd == metadata.CmdOne || q.Cmd == metadata.CmdMany || q.Cmd == metadata.CmdIter ||
 		q.Cmd == metadata.CmdBatchMany || q.Cmd == metadata.CmdBatchOne
 	return scanned && !q.Ret.isEmpty()
 }
@@ -294,3 +317,26 @@ func (q Query) TableIdentifierForMySQL() string {
 	}
 	return strings.Join(escapedNames, ".")
 }
+
+// MethodArgs returns the arguments of the generated method for the query,
+// after the context and db arguments.
+func (q Query) MethodArgs() []Argument {
+	switch q.Cmd {
+	case metadata.CmdCopyFrom, metadata.CmdBatchExec, metadata.CmdBatchMany, metadata.CmdBatchOne:
+		if q.Arg.isEmpty() {
+			return nil
+		}
+		return []Argument{{Name: q.Arg.Name, Type: "[]" + q.Arg.DefineType()}}
+	default:
+		return q.Arg.Pairs()
+	}
+}
+
+// exportedArgName returns the exported form of a method argument name, e.g.
+// to name the field that records the argument
+func exportedArgName(name string, options *opts.Options) string {
+	if _, found := options.InitialismsMap[name]; found {
+		return strings.ToUpper(name)
+	}
+	return sdk.Title(name)
+}
@@ -96,6 +96,7 @@ func buildStructs(req *plugin.GenerateRequest, options *opts.Options) []Struct {
 				addExtraGoStructTags(tags, req, options, column)
 				s.Fields = append(s.Fields, Field{
 					Name:    StructName(column.Name, options),
+					DBName:  column.Name,
 					Type:    goType(req, options, column),
 					Tags:    tags,
 					Comment: column.Comment,
@@ -261,7 +262,8 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 
 			// if query params is 2, and query params limit is 4 AND this is a copyfrom, we still want to emit the query's model
 			// otherwise we end up with a copyfrom using a struct without the struct definition
-			if len(query.Params) <= qpl && query.Cmd != ":copyfrom" {
+			// Paginated queries always use a struct, it's returned for the next page
+			if len(query.Params) <= qpl && query.Cmd != ":copyfrom" && query.Pagination == nil {
 				gq.Arg.Emit = false
 			}
 		}
@@ -325,15 +327,68 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 			}
 		}
 
+		if query.Pagination != nil {
+			p, err := buildPagination(gq, query.Pagination)
+			if err != nil {
+				return nil, err
+			}
+			gq.Pagination = p
+		}
+
 		qs = append(qs, gq)
 	}
 	sort.Slice(qs, func(i, j int) bool { return qs[i].MethodName < qs[j].MethodName })
 	return qs, nil
 }
 
+func buildPagination(q Query, p *plugin.Pagination) (*Pagination, error) {
+	field := func(v QueryValue, name string) (Field, error) {
+		if v.Struct != nil {
+			for _, f := range v.Struct.Fields {
+				if f.DBName == name {
+					return f, nil
+				}
+			}
+		}
+		return Field{}, fmt.Errorf("query %s: sqlc.paginate field for %q not found", q.MethodName, name)
+	}
+	pageSize, err := field(q.Arg, p.PageSizeParam)
+	if err != nil {
+		return nil, err
+	}
+	out := &Pagination{PageSize: pageSize.Name}
+	for i, col := range p.Columns {
+		arg, err := field(q.Arg, p.AfterParams[i])
+		if err != nil {
+			return nil, err
+		}
+		cursor := PaginationField{Arg: arg.Name}
+		retType := q.Ret.Typ
+		if q.Ret.IsStruct() {
+			ret, err := field(q.Ret, col)
+			if err != nil {
+				return nil, err
+			}
+			cursor.Ret, retType = ret.Name, ret.Type
+		}
+		// The cursor parameters are nullable, so that a NULL cursor requests
+		// the first page, while the result columns aren't
+		switch arg.Type {
+		case retType:
+		case "*" + retType:
+			cursor.Set = "pointer"
+		default:
+			cursor.Set = "scan"
+		}
+		out.Cursor = append(out.Cursor, cursor)
+	}
+	return out, nil
+}
+
 var cmdReturnsData = map[string]struct{}{
 	metadata.CmdBatchMany: {},
 	metadata.CmdBatchOne:  {},
+	metadata.CmdIter:      {},
 	metadata.CmdMany:      {},
 	metadata.CmdOne:       {},
 }
@@ -79,7 +79,13 @@ func combineAnalysis(prev *analysis, a *analyzer.Analysis) *analysis {
 	}
 	if len(prev.Columns) == len(cols) {
 		for i := range prev.Columns {
+			// Analyzers for engines that can't describe every column leave
+			// the data type empty; keep the inferred type in that case.
+			if cols[i].DataType == "" {
+				continue
+			}
 			prev.Columns[i].DataType = cols[i].DataType
+			prev.Columns[i].Unsigned = cols[i].Unsigned
 			prev.Columns[i].IsArray = cols[i].IsArray
 			prev.Columns[i].ArrayDims = cols[i].ArrayDims
 		}
@@ -96,6 +102,9 @@ func combineAnalysis(prev *analysis, a *analyzer.Analysis) *analysis {
 	}
 	if len(prev.Parameters) == len(params) {
 		for i := range prev.Parameters {
+			if params[i].Column.DataType == "" {
+				continue
+			}
 			prev.Parameters[i].Column.DataType = params[i].Column.DataType
 			prev.Parameters[i].Column.IsArray = params[i].Column.IsArray
 			prev.Parameters[i].Column.ArrayDims = params[i].Column.ArrayDims
@@ -153,6 +162,10 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 	if err := check(validate.In(c.catalog, raw)); err != nil {
 		return nil, err
 	}
+
+	if err := check(validate.GeneratedColumns(c.catalog, raw.Stmt)); err != nil {
+		return nil, err
+	}
 	rvs := rangeVars(raw.Stmt)
 	refs, errs := findParameters(raw.Stmt)
 	if len(errs) > 0 {
@@ -173,7 +186,13 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, failfast bool)
 		return nil, err
 	}
 
-	params, err := c.resolveCatalogRefs(qc, rvs, refs, namedParams, embeds)
+	// The SQLite analyzer doesn't describe parameters, so their types are
+	// also resolved from subqueries in FROM
+	var subs []*ast.RangeSubselect
+	if c.conf.Engine == config.EngineSQLite {
+		subs = rangeSubselects(raw.Stmt)
+	}
+	params, err := c.resolveCatalogRefs(qc, rvs, subs, refs, namedParams, embeds)
 	if err := check(err); err != nil {
 		return nil, err
 	}
@@ -30,6 +30,10 @@ func (c *Compiler) parseCatalog(schemas []string) error {
 	if err != nil {
 		return err
 	}
+	files, err = migrations.Order(files)
+	if err != nil {
+		return err
+	}
 	merr := multierr.New()
 	for _, filename := range files {
 		blob, err := os.ReadFile(filename)
@@ -71,40 +75,10 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 			merr.Add(filename, "", 0, err)
 			continue
 		}
-		src := string(blob)
-		stmts, err := c.parser.Parse(strings.NewReader(src))
-		if err != nil {
-			merr.Add(filename, src, 0, err)
-			continue
-		}
-		for _, stmt := range stmts {
-			query, err := c.parseQuery(stmt.Raw, src, o)
-			if err != nil {
-				var e *sqlerr.Error
-				loc := stmt.Raw.Pos()
-				if errors.As(err, &e) && e.Location != 0 {
-					loc = e.Location
-				}
-				merr.Add(filename, src, loc, err)
-				// If this rpc unauthenticated error bubbles up, then all future parsing/analysis will fail
-				if errors.Is(err, rpc.ErrUnauthenticated) {
-					return nil, merr
-				}
-				continue
-			}
-			if query == nil {
-				continue
-			}
-			query.Metadata.Filename = filepath.Base(filename)
-			queryName := query.Metadata.Name
-			if queryName != "" {
-				if _, exists := set[queryName]; exists {
-					merr.Add(filename, src, stmt.Raw.Pos(), fmt.Errorf("duplicate query name: %s", queryName))
-					continue
-				}
-				set[queryName] = struct{}{}
-			}
-			q = append(q, query)
+		queries, ok := c.parseFile(filename, string(blob), o, set, merr)
+		q = append(q, queries...)
+		if !ok {
+			return nil, merr
 		}
 	}
 	if len(merr.Errs()) > 0 {
@@ -118,3 +92,45 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 		Queries: q,
 	}, nil
 }
+
+// parseFile compiles the queries in src, adding errors to merr. Query names
+// are checked against set, which is shared by all files of a package. It
+// returns false if the remaining files can't be compiled either.
+func (c *Compiler) parseFile(filename, src string, o opts.Parser, set map[string]struct{}, merr *multierr.Error) ([]*Query, bool) {
+	var q []*Query
+	stmts, err := c.parser.Parse(strings.NewReader(src))
+	if err != nil {
+		merr.Add(filename, src, 0, err)
+		return nil, true
+	}
+	for _, stmt := range stmts {
+		query, err := c.parseQuery(stmt.Raw, src, o)
+		if err != nil {
+			var e *sqlerr.Error
+			loc := stmt.Raw.Pos()
+			if errors.As(err, &e) && e.Location != 0 {
+				loc = e.Location
+			}
+			merr.Add(filename, src, loc, err)
+			// If this rpc unauthenticated error bubbles up, then all future parsing/analysis will fail
+			if errors.Is(err, rpc.ErrUnauthenticated) {
+				return q, false
+			}
+			continue
+		}
+		if query == nil {
+			continue
+		}
+		query.Metadata.Filename = filepath.Base(filename)
+		queryName := query.Metadata.Name
+		if queryName != "" {
+			if _, exists := set[queryName]; exists {
+				merr.Add(filename, src, stmt.Raw.Pos(), fmt.Errorf("duplicate query name: %s", queryName))
+				continue
+			}
+			set[queryName] = struct{}{}
+		}
+		q = append(q, query)
+	}
+	return q, true
+}
@@ -0,0 +1,38 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+)
+
+// resolveDomain replaces a domain type by its base type, so code generators
+// don't need to know about domains. The domain is kept in col.Domain.
+func (c *Compiler) resolveDomain(col *Column) {
+	if col == nil || c.catalog == nil {
+		return
+	}
+	typ := col.Type
+	if typ == nil {
+		typ = &ast.TypeName{Name: col.DataType}
+		if schema, name, ok := strings.Cut(col.DataType, "."); ok {
+			typ = &ast.TypeName{Schema: schema, Name: name}
+		}
+	}
+	d := c.catalog.GetDomain(typ)
+	if d == nil {
+		return
+	}
+	col.Domain = &ast.TypeName{
+		Catalog: typ.Catalog,
+		Schema:  typ.Schema,
+		Name:    typ.Name,
+	}
+	base := d.BaseType
+	col.Type = &base
+	col.DataType = dataType(&base)
+	if d.IsArray {
+		col.IsArray = true
+		col.ArrayDims += d.ArrayDims
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/dbmanager"
 	"github.com/sqlc-dev/sqlc/internal/engine/dolphin"
+	myanalyze "github.com/sqlc-dev/sqlc/internal/engine/dolphin/analyzer"
 	"github.com/sqlc-dev/sqlc/internal/engine/postgresql"
 	pganalyze "github.com/sqlc-dev/sqlc/internal/engine/postgresql/analyzer"
 	"github.com/sqlc-dev/sqlc/internal/engine/sqlite"
+	sqliteanalyze "github.com/sqlc-dev/sqlc/internal/engine/sqlite/analyzer"
+	"github.com/sqlc-dev/sqlc/internal/multierr"
 	"github.com/sqlc-dev/sqlc/internal/opts"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
 )
@@ -39,9 +42,29 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings) (*Compiler, err
 	case config.EngineSQLite:
 		c.parser = sqlite.NewParser()
 		c.catalog = sqlite.NewCatalog()
+		if conf.Database != nil {
+			if conf.Analyzer.Database != nil && *conf.Analyzer.Database {
+				c.analyzer = analyzer.Cached(
+					sqliteanalyze.New(*conf.Database),
+					combo.Global,
+					*conf.Database,
+				)
+			}
+		}
 	case config.EngineMySQL:
 		c.parser = dolphin.NewParser()
 		c.catalog = dolphin.NewCatalog()
+		// The MySQL analyzer executes SELECT statements to describe their
+		// results, so it only runs when enabled explicitly
+		if conf.Database != nil {
+			if conf.Analyzer.Database != nil && *conf.Analyzer.Database {
+				c.analyzer = analyzer.Cached(
+					myanalyze.New(c.client, *conf.Database),
+					combo.Global,
+					*conf.Database,
+				)
+			}
+		}
 	case config.EnginePostgreSQL:
 		c.parser = postgresql.NewParser()
 		c.catalog = postgresql.NewCatalog()
@@ -64,6 +87,10 @@ func (c *Compiler) Catalog() *catalog.Catalog {
 	return c.catalog
 }
 
+func (c *Compiler) Parser() Parser {
+	return c.parser
+}
+
 func (c *Compiler) ParseCatalog(schema []string) error {
 	return c.parseCatalog(schema)
 }
@@ -77,6 +104,18 @@ func (c *Compiler) ParseQueries(queries []string, o opts.Parser) error {
 	return nil
 }
 
+// ParseQuerySource compiles the queries in src as if they were read from
+// filename, which doesn't need to exist. The queries that compiled are
+// returned along with a *multierr.Error for the ones that didn't.
+func (c *Compiler) ParseQuerySource(filename, src string, o opts.Parser) ([]*Query, error) {
+	merr := multierr.New()
+	q, _ := c.parseFile(filename, src, o, map[string]struct{}{}, merr)
+	if len(merr.Errs()) > 0 {
+		return q, merr
+	}
+	return q, nil
+}
+
 func (c *Compiler) Result() *Result {
 	return c.result
 }
@@ -127,7 +127,9 @@ func (c *Compiler) expandStmt(qc *QueryCatalog, raw *ast.RawStmt, node ast.Node)
 		if scope == "" {
 			for _, t := range tables {
 				for _, c := range t.Columns {
-					counts[c.Name] += 1
+					if !c.hidden {
+						counts[c.Name] += 1
+					}
 				}
 			}
 		}
@@ -138,6 +140,9 @@ func (c *Compiler) expandStmt(qc *QueryCatalog, raw *ast.RawStmt, node ast.Node)
 			tableName := c.quoteIdent(t.Rel.Name)
 			scopeName := c.quoteIdent(scope)
 			for _, column := range t.Columns {
+				if column.hidden {
+					continue
+				}
 				cname := column.Name
 				if res.Name != nil {
 					cname = *res.Name
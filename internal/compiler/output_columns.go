@@ -273,6 +273,9 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 						continue
 					}
 					for _, c := range t.Columns {
+						if c.hidden {
+							continue
+						}
 						cname := c.Name
 						if res.Name != nil {
 							cname = *res.Name
@@ -290,6 +293,7 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 							IsArray:      c.IsArray,
 							ArrayDims:    c.ArrayDims,
 							Length:       c.Length,
+							Comment:      c.Comment,
 						})
 					}
 				}
@@ -546,7 +550,7 @@ func (c *Compiler) sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, erro
 				})
 			}
 			if table == nil || err != nil {
-				if n.Alias != nil && len(n.Alias.Colnames.Items) > 0 {
+				if n.Alias != nil && n.Alias.Colnames != nil && len(n.Alias.Colnames.Items) > 0 {
 					table = &Table{}
 					for _, colName := range n.Alias.Colnames.Items {
 						table.Columns = append(table.Columns, &Column{
@@ -680,6 +684,7 @@ func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef)
 					Length:       c.Length,
 					EmbedTable:   c.EmbedTable,
 					OriginalName: c.Name,
+					Comment:      c.Comment,
 				})
 			}
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/debug"
@@ -12,6 +13,7 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/source"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/rewrite"
 	"github.com/sqlc-dev/sqlc/internal/sql/validate"
 )
 
@@ -54,6 +56,36 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		return nil, err
 	}
 
+	expandedSQL, pagination, err := rewrite.Paginate(c.conf.Engine, raw, rawSQL)
+	if err != nil {
+		return nil, err
+	}
+	if pagination != nil {
+		if cmd != metadata.CmdMany {
+			return nil, fmt.Errorf("sqlc.paginate can only be used with the %s command", metadata.CmdMany)
+		}
+		// Parse the expanded query again. The padding keeps the locations in
+		// error messages pointing into the original file.
+		padded := strings.Repeat(" ", raw.StmtLocation) + expandedSQL
+		stmts, err := c.parser.Parse(strings.NewReader(padded))
+		if err != nil {
+			return nil, fmt.Errorf("expanded sqlc.paginate syntax is invalid: %w", err)
+		}
+		if len(stmts) != 1 {
+			return nil, errors.New("expanded sqlc.paginate query must contain one statement")
+		}
+		raw = stmts[0].Raw
+		if raw.StmtLen == 0 {
+			// The PostgreSQL parser leaves the length of a statement without
+			// a trailing semicolon unset
+			raw.StmtLen = len(padded) - raw.StmtLocation
+		}
+		rawSQL, err = source.Pluck(padded, raw.StmtLocation, raw.StmtLen)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	md := metadata.Metadata{
 		Name: name,
 		Cmd:  cmd,
@@ -69,6 +101,10 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if err != nil {
 		return nil, err
 	}
+	md.Budget, err = metadata.ParseBudget(cleanedComments)
+	if err != nil {
+		md.Budget = &metadata.Budget{Invalid: err.Error()}
+	}
 
 	var anlys *analysis
 	if c.analyzer != nil {
@@ -106,6 +142,27 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		}
 	}
 
+	for _, col := range anlys.Columns {
+		c.resolveDomain(col)
+	}
+	for _, p := range anlys.Parameters {
+		c.resolveDomain(p.Column)
+	}
+
+	if pagination != nil {
+		for _, name := range pagination.Columns {
+			i := slices.IndexFunc(anlys.Columns, func(col *Column) bool { return col.Name == name })
+			if i < 0 {
+				return nil, fmt.Errorf("sqlc.paginate column %q must be one of the query's result columns", name)
+			}
+			// Rows with a NULL cursor value would never be returned, since
+			// comparisons with NULL are never true
+			if !anlys.Columns[i].NotNull {
+				return nil, fmt.Errorf("sqlc.paginate column %q must be NOT NULL", name)
+			}
+		}
+	}
+
 	expanded := anlys.Query
 
 	// If the query string was edited, make sure the syntax is valid
@@ -129,6 +186,7 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		Columns:         anlys.Columns,
 		SQL:             trimmed,
 		InsertIntoTable: anlys.Table,
+		Pagination:      pagination,
 	}, nil
 }
 
@@ -144,6 +202,18 @@ func rangeVars(root ast.Node) []*ast.RangeVar {
 	return vars
 }
 
+// rangeSubselects returns the subqueries in FROM clauses that have an alias
+func rangeSubselects(root ast.Node) []*ast.RangeSubselect {
+	var subs []*ast.RangeSubselect
+	find := astutils.VisitorFunc(func(node ast.Node) {
+		if n, ok := node.(*ast.RangeSubselect); ok && n.Alias != nil && n.Alias.Aliasname != nil {
+			subs = append(subs, n)
+		}
+	})
+	astutils.Walk(find, root)
+	return subs
+}
+
 func uniqueParamRefs(in []paramRef, dollar bool) []paramRef {
 	m := make(map[int]bool, len(in))
 	o := make([]paramRef, 0, len(in))
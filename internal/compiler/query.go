@@ -4,6 +4,7 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/metadata"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/rewrite"
 )
 
 type Function struct {
@@ -36,10 +37,15 @@ type Column struct {
 	TableAlias string
 	Type       *ast.TypeName
 	EmbedTable *ast.TableName
+	// Domain is the domain the column was declared with, Type is its base
+	// type
+	Domain *ast.TypeName
 
 	IsSqlcSlice bool // is this sqlc.slice()
 
 	skipTableRequiredCheck bool
+	// hidden columns aren't expanded from a star
+	hidden bool
 }
 
 type Query struct {
@@ -51,6 +57,9 @@ type Query struct {
 	// Needed for CopyFrom
 	InsertIntoTable *ast.TableName
 
+	// Needed for sqlc.paginate
+	Pagination *rewrite.Pagination
+
 	// Needed for vet
 	RawStmt *ast.RawStmt
 }
@@ -74,6 +74,8 @@ func ConvertColumn(rel *ast.TableName, c *catalog.Column) *Column {
 		ArrayDims: c.ArrayDims,
 		Type:      &c.Type,
 		Length:    c.Length,
+		Comment:   c.Comment,
+		hidden:    c.IsHidden,
 	}
 }
 
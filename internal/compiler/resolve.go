@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"strconv"
 
+	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
@@ -21,7 +22,7 @@ func dataType(n *ast.TypeName) string {
 	}
 }
 
-func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar, args []paramRef, params *named.ParamSet, embeds rewrite.EmbedSet) ([]Parameter, error) {
+func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar, subs []*ast.RangeSubselect, args []paramRef, params *named.ParamSet, embeds rewrite.EmbedSet) ([]Parameter, error) {
 	c := comp.catalog
 
 	aliasMap := map[string]*ast.TableName{}
@@ -50,6 +51,10 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 		return nil
 	}
 
+	// On SQLite, tables referenced more than once without an alias, like in
+	// the arms of a UNION, are only indexed once so their columns aren't
+	// ambiguous
+	unaliased := map[string]bool{}
 	for _, rv := range rvs {
 		if rv.Relname == nil {
 			continue
@@ -61,6 +66,13 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 		if _, found := aliasMap[fqn.Name]; found {
 			continue
 		}
+		if rv.Alias == nil && comp.conf.Engine == config.EngineSQLite {
+			key := fqn.Schema + "." + fqn.Name
+			if unaliased[key] {
+				continue
+			}
+			unaliased[key] = true
+		}
 		table, err := c.GetTable(fqn)
 		if err != nil {
 			if qc == nil {
@@ -81,6 +93,33 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 		}
 	}
 
+	// Subqueries in FROM are indexed like tables, under their alias
+	for _, sub := range subs {
+		cols, err := comp.outputColumns(qc, sub.Subquery)
+		if err != nil {
+			continue
+		}
+		table := catalog.Table{Rel: &ast.TableName{Name: *sub.Alias.Aliasname}}
+		for _, col := range cols {
+			typ := ast.TypeName{Name: col.DataType}
+			if col.Type != nil {
+				typ = *col.Type
+			}
+			table.Columns = append(table.Columns, &catalog.Column{
+				Name:       col.Name,
+				Type:       typ,
+				IsNotNull:  col.NotNull,
+				IsUnsigned: col.Unsigned,
+				IsArray:    col.IsArray,
+				ArrayDims:  col.ArrayDims,
+				Length:     col.Length,
+			})
+		}
+		if err := indexTable(table); err != nil {
+			return nil, err
+		}
+	}
+
 	// resolve a table for an embed
 	for _, embed := range embeds {
 		table, err := c.GetTable(embed.Table)
@@ -144,12 +183,24 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 		case *ast.A_Expr:
 			// TODO: While this works for a wide range of simple expressions,
 			// more complicated expressions will cause this logic to fail.
-			list := astutils.Search(n.Lexpr, func(node ast.Node) bool {
+			lexpr, rexpr := n.Lexpr, n.Rexpr
+
+			// In row comparisons, like (a, b) > ($1, $2), a parameter takes
+			// the type of the column in the same position
+			if l, r := rowItems(n.Lexpr), rowItems(n.Rexpr); len(l) > 1 && len(l) == len(r) {
+				for i := range r {
+					if r[i] == ref.ref {
+						lexpr, rexpr = l[i], r[i]
+					}
+				}
+			}
+
+			list := astutils.Search(lexpr, func(node ast.Node) bool {
 				_, ok := node.(*ast.ColumnRef)
 				return ok
 			})
 			if len(list.Items) == 0 {
-				list = astutils.Search(n.Rexpr, func(node ast.Node) bool {
+				list = astutils.Search(rexpr, func(node ast.Node) bool {
 					_, ok := node.(*ast.ColumnRef)
 					return ok
 				})
@@ -635,3 +686,17 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 	}
 	return a, nil
 }
+
+// rowItems returns the items of a row constructor, like (a, b). SQLite
+// represents these as plain lists.
+func rowItems(node ast.Node) []ast.Node {
+	switch n := node.(type) {
+	case *ast.RowExpr:
+		if n.Args != nil {
+			return n.Args.Items
+		}
+	case *ast.List:
+		return n.Items
+	}
+	return nil
+}
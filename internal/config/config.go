@@ -98,9 +98,12 @@ type Plugin struct {
 }
 
 type Rule struct {
-	Name string `json:"name" yaml:"name"`
-	Rule string `json:"rule" yaml:"rule"`
-	Msg  string `json:"message" yaml:"message"`
+	Name     string    `json:"name" yaml:"name"`
+	Rule     string    `json:"rule" yaml:"rule"`
+	Msg      string    `json:"message" yaml:"message"`
+	Plugin   string    `json:"plugin" yaml:"plugin"`
+	Options  yaml.Node `json:"options" yaml:"options"`
+	Severity string    `json:"severity" yaml:"severity"`
 }
 
 type Overrides struct {
@@ -118,18 +121,28 @@ type SQL struct {
 	Gen                  SQLGen    `json:"gen" yaml:"gen"`
 	Codegen              []Codegen `json:"codegen" yaml:"codegen"`
 	Rules                []string  `json:"rules" yaml:"rules"`
+	ExcludeRules         []Exclude `json:"exclude_rules" yaml:"exclude_rules"`
 	Analyzer             Analyzer  `json:"analyzer" yaml:"analyzer"`
 }
 
+// Exclude turns off vet rules for the query files in a directory, or
+// matching a file name or glob
+type Exclude struct {
+	Path string `json:"path" yaml:"path"`
+	// Rules to turn off, or every rule when empty
+	Rules []string `json:"rules" yaml:"rules"`
+}
+
 type Analyzer struct {
 	Database *bool `json:"database" yaml:"database"`
 }
 
 // TODO: Figure out a better name for this
 type Codegen struct {
-	Out     string    `json:"out" yaml:"out"`
-	Plugin  string    `json:"plugin" yaml:"plugin"`
-	Options yaml.Node `json:"options" yaml:"options"`
+	Out      string    `json:"out" yaml:"out"`
+	Plugin   string    `json:"plugin" yaml:"plugin"`
+	Options  yaml.Node `json:"options" yaml:"options"`
+	Severity string    `json:"severity" yaml:"severity"`
 }
 
 type SQLGen struct {
@@ -28,6 +28,8 @@ type v1PackageSettings struct {
 	Schema                    Paths             `json:"schema" yaml:"schema"`
 	Queries                   Paths             `json:"queries" yaml:"queries"`
 	EmitInterface             bool              `json:"emit_interface" yaml:"emit_interface"`
+	EmitMock                  bool              `json:"emit_mock,omitempty" yaml:"emit_mock"`
+	EmitTxHelpers             bool              `json:"emit_tx_helpers,omitempty" yaml:"emit_tx_helpers"`
 	EmitJSONTags              bool              `json:"emit_json_tags" yaml:"emit_json_tags"`
 	JsonTagsIDUppercase       bool              `json:"json_tags_id_uppercase" yaml:"json_tags_id_uppercase"`
 	EmitDBTags                bool              `json:"emit_db_tags" yaml:"emit_db_tags"`
@@ -41,6 +43,8 @@ type v1PackageSettings struct {
 	EmitPointersForNullTypes  bool              `json:"emit_pointers_for_null_types" yaml:"emit_pointers_for_null_types"`
 	EmitEnumValidMethod       bool              `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
 	EmitAllEnumValues         bool              `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
+	EmitDomainTypes           bool              `json:"emit_domain_types,omitempty" yaml:"emit_domain_types"`
+	EmitCompositeTypes        bool              `json:"emit_composite_types,omitempty" yaml:"emit_composite_types"`
 	EmitSqlAsComment          bool              `json:"emit_sql_as_comment,omitempty" yaml:"emit_sql_as_comment"`
 	JSONTagsCaseStyle         string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	SQLPackage                string            `json:"sql_package" yaml:"sql_package"`
@@ -51,6 +55,8 @@ type v1PackageSettings struct {
 	OutputModelsFileName      string            `json:"output_models_file_name,omitempty" yaml:"output_models_file_name"`
 	OutputQuerierFileName     string            `json:"output_querier_file_name,omitempty" yaml:"output_querier_file_name"`
 	OutputCopyFromFileName    string            `json:"output_copyfrom_file_name,omitempty" yaml:"output_copyfrom_file_name"`
+	OutputMockFileName        string            `json:"output_mock_file_name,omitempty" yaml:"output_mock_file_name"`
+	OutputTxFileName          string            `json:"output_tx_file_name,omitempty" yaml:"output_tx_file_name"`
 	OutputFilesSuffix         string            `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
 	StrictFunctionChecks      bool              `json:"strict_function_checks" yaml:"strict_function_checks"`
 	StrictOrderBy             *bool             `json:"strict_order_by" yaml:"strict_order_by"`
@@ -138,6 +144,8 @@ func (c *V1GenerateSettings) Translate() Config {
 			Gen: SQLGen{
 				Go: &golang.Options{
 					EmitInterface:             pkg.EmitInterface,
+					EmitMock:                  pkg.EmitMock,
+					EmitTxHelpers:             pkg.EmitTxHelpers,
 					EmitJsonTags:              pkg.EmitJSONTags,
 					JsonTagsIdUppercase:       pkg.JsonTagsIDUppercase,
 					EmitDbTags:                pkg.EmitDBTags,
@@ -151,6 +159,8 @@ func (c *V1GenerateSettings) Translate() Config {
 					EmitPointersForNullTypes:  pkg.EmitPointersForNullTypes,
 					EmitEnumValidMethod:       pkg.EmitEnumValidMethod,
 					EmitAllEnumValues:         pkg.EmitAllEnumValues,
+					EmitDomainTypes:           pkg.EmitDomainTypes,
+					EmitCompositeTypes:        pkg.EmitCompositeTypes,
 					EmitSqlAsComment:          pkg.EmitSqlAsComment,
 					Package:                   pkg.Name,
 					Out:                       pkg.Path,
@@ -163,6 +173,8 @@ func (c *V1GenerateSettings) Translate() Config {
 					OutputModelsFileName:      pkg.OutputModelsFileName,
 					OutputQuerierFileName:     pkg.OutputQuerierFileName,
 					OutputCopyfromFileName:    pkg.OutputCopyFromFileName,
+					OutputMockFileName:        pkg.OutputMockFileName,
+					OutputTxFileName:          pkg.OutputTxFileName,
 					OutputFilesSuffix:         pkg.OutputFilesSuffix,
 					QueryParameterLimit:       pkg.QueryParameterLimit,
 					OmitSqlcVersion:           pkg.OmitSqlcVersion,
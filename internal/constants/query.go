@@ -4,9 +4,17 @@ package constants
 const (
 	QueryFlagParam          = "@param"
 	QueryFlagSqlcVetDisable = "@sqlc-vet-disable"
+	QueryFlagSqlcBudget     = "@sqlc-budget"
 )
 
 // Rules
 const (
-	QueryRuleDbPrepare = "sqlc/db-prepare"
+	QueryRuleDbPrepare           = "sqlc/db-prepare"
+	QueryRuleMissingWhere        = "sqlc/missing-where"
+	QueryRuleSelectStar          = "sqlc/select-star"
+	QueryRuleLimitWithoutOrderBy = "sqlc/limit-without-order-by"
+	QueryRuleNotInNullable       = "sqlc/not-in-nullable"
+	QueryRuleImplicitCrossJoin   = "sqlc/implicit-cross-join"
+	QueryRuleOffsetPagination    = "sqlc/offset-pagination"
+	QueryRuleBudget              = "sqlc/budget"
 )
@@ -0,0 +1,54 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+
+package querytest
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type DBTX interface {
+	Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error)
+	Query(context.Context, string, ...interface{}) (pgx.Rows, error)
+	QueryRow(context.Context, string, ...interface{}) pgx.Row
+}
+
+func New(db DBTX) *Queries {
+	return &Queries{db: db}
+}
+
+type Queries struct {
+	db DBTX
+}
+
+func (q *Queries) WithTx(tx pgx.Tx) *Queries {
+	return &Queries{
+		db: tx,
+	}
+}
+
+// RegisterTypes loads the composite types of the package from the
+// database and registers them with conn, so they can be scanned into and
+// encoded from the generated structs. Call it for every new connection, for
+// example in pgxpool.Config.AfterConnect.
+func RegisterTypes(ctx context.Context, conn *pgx.Conn) error {
+	for _, name := range []pgx.Identifier{
+		{"address"},
+		{"_address"},
+		{"status"},
+		{"_status"},
+		{"contact"},
+		{"_contact"},
+	} {
+		typ, err := conn.LoadType(ctx, name.Sanitize())
+		if err != nil {
+			return err
+		}
+		conn.TypeMap().RegisterType(typ)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const createPerson = `-- name: CreatePerson :one
+INSERT INTO people (contact, addresses) VALUES ($1, $2) RETURNING id
+`
+
+type CreatePersonParams struct {
+	Contact   Contact
+	Addresses []Address
+}
+
+func (q *Queries) CreatePerson(ctx context.Context, arg CreatePersonParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createPerson, arg.Contact, arg.Addresses)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getPerson = `-- name: GetPerson :one
+SELECT id, contact, addresses FROM people WHERE id = $1
+`
+
+func (q *Queries) GetPerson(ctx context.Context, id int64) (Person, error) {
+	row := q.db.QueryRow(ctx, getPerson, id)
+	var i Person
+	err := row.Scan(&i.ID, &i.Contact, &i.Addresses)
+	return i, err
+}
+
+const primaryAddress = `-- name: PrimaryAddress :one
+SELECT primary_address($1)
+`
+
+func (q *Queries) PrimaryAddress(ctx context.Context, personID int64) (Address, error) {
+	row := q.db.QueryRow(ctx, primaryAddress, personID)
+	var primary_address Address
+	err := row.Scan(&primary_address)
+	return primary_address, err
+}
@@ -0,0 +1,259 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+
+package querytest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type Status string
+
+const (
+	StatusActive   Status = "active"
+	StatusArchived Status = "archived"
+)
+
+func (e *Status) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = Status(s)
+	case string:
+		*e = Status(s)
+	default:
+		return fmt.Errorf("unsupported scan type for Status: %T", src)
+	}
+	return nil
+}
+
+type NullStatus struct {
+	Status Status
+	Valid  bool // Valid is true if Status is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.Status, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.Status.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.Status), nil
+}
+
+type Person struct {
+	ID        int64
+	Contact   Contact
+	Addresses []Address
+}
+
+type Address struct {
+	Street sql.NullString
+	City   sql.NullString
+	Tags   []string
+}
+
+// Scan implements the Scanner interface.
+func (t *Address) Scan(src interface{}) error {
+	fields, err := parseRecord(src, 3)
+	if err != nil {
+		return fmt.Errorf("scan Address: %w", err)
+	}
+	if err := scanRecordField(&t.Street, fields[0]); err != nil {
+		return fmt.Errorf("scan Address.Street: %w", err)
+	}
+	if err := scanRecordField(&t.City, fields[1]); err != nil {
+		return fmt.Errorf("scan Address.City: %w", err)
+	}
+	if err := scanRecordArray(pq.Array(&t.Tags), fields[2]); err != nil {
+		return fmt.Errorf("scan Address.Tags: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (t Address) Value() (driver.Value, error) {
+	return recordValue(t.Street, t.City, pq.Array(t.Tags))
+}
+
+type Contact struct {
+	Name      sql.NullString
+	Status    NullStatus
+	Home      *Address
+	CreatedAt sql.NullTime
+}
+
+// Scan implements the Scanner interface.
+func (t *Contact) Scan(src interface{}) error {
+	fields, err := parseRecord(src, 4)
+	if err != nil {
+		return fmt.Errorf("scan Contact: %w", err)
+	}
+	if err := scanRecordField(&t.Name, fields[0]); err != nil {
+		return fmt.Errorf("scan Contact.Name: %w", err)
+	}
+	if err := scanRecordField(&t.Status, fields[1]); err != nil {
+		return fmt.Errorf("scan Contact.Status: %w", err)
+	}
+	if err := scanRecordField(&t.Home, fields[2]); err != nil {
+		return fmt.Errorf("scan Contact.Home: %w", err)
+	}
+	if err := scanRecordField(&t.CreatedAt, fields[3]); err != nil {
+		return fmt.Errorf("scan Contact.CreatedAt: %w", err)
+	}
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (t Contact) Value() (driver.Value, error) {
+	return recordValue(t.Name, t.Status, t.Home, t.CreatedAt)
+}
+
+// parseRecord splits the text representation of a composite type into its
+// fields. NULL fields are nil.
+func parseRecord(src interface{}, n int) ([]*string, error) {
+	var s string
+	switch src := src.(type) {
+	case []byte:
+		s = string(src)
+	case string:
+		s = src
+	default:
+		return nil, fmt.Errorf("unsupported scan type: %T", src)
+	}
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil, fmt.Errorf("malformed record: %q", s)
+	}
+	s = s[1 : len(s)-1]
+	var fields []*string
+	for i := 0; i <= len(s); i++ {
+		var b strings.Builder
+		null, quoted := true, false
+		for ; i < len(s) && (quoted || s[i] != ','); i++ {
+			null = false
+			switch {
+			case s[i] == '\\' && i+1 < len(s):
+				i++
+				b.WriteByte(s[i])
+			case s[i] == '"' && quoted && i+1 < len(s) && s[i+1] == '"':
+				i++
+				b.WriteByte('"')
+			case s[i] == '"':
+				quoted = !quoted
+			default:
+				b.WriteByte(s[i])
+			}
+		}
+		if null {
+			fields = append(fields, nil)
+			continue
+		}
+		field := b.String()
+		fields = append(fields, &field)
+	}
+	if len(fields) != n {
+		return nil, fmt.Errorf("expected %d fields, got %d", n, len(fields))
+	}
+	return fields, nil
+}
+
+// scanRecordField converts a field of a composite type the same way
+// database/sql converts the columns of a row
+func scanRecordField[T any](dest *T, src *string) error {
+	if src != nil {
+		switch dest := any(dest).(type) {
+		case *time.Time:
+			t, err := parseRecordTime(*src)
+			if err != nil {
+				return err
+			}
+			*dest = t
+			return nil
+		case *sql.NullTime:
+			t, err := parseRecordTime(*src)
+			if err != nil {
+				return err
+			}
+			*dest = sql.NullTime{Time: t, Valid: true}
+			return nil
+		}
+	}
+	var v sql.Null[T]
+	if src != nil {
+		if err := v.Scan(*src); err != nil {
+			return err
+		}
+	}
+	*dest = v.V
+	return nil
+}
+
+func scanRecordArray(dest interface{}, src *string) error {
+	if src == nil {
+		return dest.(sql.Scanner).Scan(nil)
+	}
+	return dest.(sql.Scanner).Scan(*src)
+}
+
+func parseRecordTime(s string) (time.Time, error) {
+	for _, layout := range []string{
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999Z07",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02",
+		"15:04:05.999999999",
+	} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %q", s)
+}
+
+// recordValue formats fields as the text representation of a composite type
+func recordValue(fields ...interface{}) (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('(')
+	for i, field := range fields {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		v, err := driver.DefaultParameterConverter.ConvertValue(field)
+		if err != nil {
+			return nil, err
+		}
+		var s string
+		switch v := v.(type) {
+		case nil:
+			continue
+		case []byte:
+			s = string(v)
+		case string:
+			s = v
+		case time.Time:
+			s = v.Format("2006-01-02 15:04:05.999999999Z07:00")
+		default:
+			s = fmt.Sprint(v)
+		}
+		b.WriteByte('"')
+		b.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s))
+		b.WriteByte('"')
+	}
+	b.WriteByte(')')
+	return b.String(), nil
+}
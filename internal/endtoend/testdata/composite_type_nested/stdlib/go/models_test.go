@@ -0,0 +1,125 @@
+package querytest
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func ptr(s string) *string {
+	return &s
+}
+
+func TestParseRecord(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		src  interface{}
+		n    int
+		want []*string
+	}{
+		{"Plain", "(Main St,Springfield)", 2, []*string{ptr("Main St"), ptr("Springfield")}},
+		{"Bytes", []byte("(1,2)"), 2, []*string{ptr("1"), ptr("2")}},
+		{"Null", "(,Springfield,)", 3, []*string{nil, ptr("Springfield"), nil}},
+		{"SingleNull", "()", 1, []*string{nil}},
+		{"EmptyString", `("",x)`, 2, []*string{ptr(""), ptr("x")}},
+		{"QuotedComma", `("Main St, 1",x)`, 2, []*string{ptr("Main St, 1"), ptr("x")}},
+		{"DoubledQuote", `("say ""hi""",x)`, 2, []*string{ptr(`say "hi"`), ptr("x")}},
+		{"Backslash", `("a\\b\"c",x)`, 2, []*string{ptr(`a\b"c`), ptr("x")}},
+		{"Nested", `("(""Main St"",Springfield,)",active)`, 2, []*string{ptr(`("Main St",Springfield,)`), ptr("active")}},
+		{"Array", `("{a,b}",x)`, 2, []*string{ptr("{a,b}"), ptr("x")}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseRecord(tc.src, tc.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("got %v, want %v", deref(got), deref(tc.want))
+			}
+		})
+	}
+}
+
+func deref(fields []*string) []interface{} {
+	var out []interface{}
+	for _, f := range fields {
+		if f == nil {
+			out = append(out, nil)
+		} else {
+			out = append(out, *f)
+		}
+	}
+	return out
+}
+
+func TestParseRecordErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		src  interface{}
+		n    int
+	}{
+		{"Unsupported", 42, 1},
+		{"Empty", "", 1},
+		{"Unclosed", "(a,b", 2},
+		{"TooFew", "(a,b)", 3},
+		{"TooMany", "(a,b)", 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := parseRecord(tc.src, tc.n); err == nil {
+				t.Errorf("expected an error for %v", tc.src)
+			}
+		})
+	}
+}
+
+func TestRecordValue(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name   string
+		fields []interface{}
+		want   string
+	}{
+		{"Plain", []interface{}{"Main St", "Springfield"}, `("Main St","Springfield")`},
+		{"Null", []interface{}{sql.NullString{}, "x", nil}, `(,"x",)`},
+		{"Valid", []interface{}{sql.NullString{String: "a", Valid: true}}, `("a")`},
+		{"Empty", []interface{}{""}, `("")`},
+		{"Escaped", []interface{}{`say "hi" \o/`}, `("say \"hi\" \\o/")`},
+		{"Numbers", []interface{}{int64(7), 1.5, true}, `("7","1.5","true")`},
+		{"Time", []interface{}{created}, `("2024-03-01 12:30:00Z")`},
+		{"Nested", []interface{}{Address{City: sql.NullString{String: "Springfield", Valid: true}}}, `("(,\"Springfield\",)")`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := recordValue(tc.fields...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContactRoundTrip(t *testing.T) {
+	want := Contact{
+		Name:   sql.NullString{String: `O'Brien, "Pat"`, Valid: true},
+		Status: NullStatus{Status: StatusActive, Valid: true},
+		Home: &Address{
+			Street: sql.NullString{String: `1 Main St\Apt 2`, Valid: true},
+			Tags:   []string{"home", "a,b"},
+		},
+		CreatedAt: sql.NullTime{Time: time.Date(2024, 3, 1, 12, 30, 0, 500, time.UTC), Valid: true},
+	}
+	v, err := want.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Contact
+	if err := got.Scan(v); err != nil {
+		t.Fatalf("scan %s: %s", v, err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
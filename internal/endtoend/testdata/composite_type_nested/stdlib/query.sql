@@ -0,0 +1,8 @@
+-- name: GetPerson :one
+SELECT * FROM people WHERE id = $1;
+
+-- name: CreatePerson :one
+INSERT INTO people (contact, addresses) VALUES ($1, $2) RETURNING id;
+
+-- name: PrimaryAddress :one
+SELECT primary_address($1);
@@ -0,0 +1,24 @@
+CREATE TYPE status AS ENUM ('active', 'archived');
+
+CREATE TYPE address AS (
+    street text,
+    city text,
+    tags text[]
+);
+
+CREATE TYPE contact AS (
+    name text,
+    status status,
+    home address,
+    created_at timestamp
+);
+
+CREATE TABLE people (
+    id bigserial PRIMARY KEY,
+    contact contact NOT NULL,
+    addresses address[] NOT NULL
+);
+
+CREATE FUNCTION primary_address(person_id bigint) RETURNS address AS $$
+    SELECT addresses[1] FROM people WHERE id = person_id
+$$ LANGUAGE sql;
@@ -0,0 +1,5 @@
+-- name: ListNovels :many
+SELECT id, name, author_id, notes FROM novels;
+
+-- name: ListAuthors :many
+SELECT * FROM "Authors";
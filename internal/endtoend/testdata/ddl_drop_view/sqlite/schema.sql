@@ -0,0 +1,28 @@
+CREATE TABLE "Authors" (
+    id   INTEGER PRIMARY KEY,
+    name TEXT    NOT NULL
+);
+
+CREATE TABLE books (
+    id    INTEGER PRIMARY KEY,
+    title TEXT    NOT NULL
+);
+
+CREATE VIEW author_names AS SELECT name FROM "Authors";
+CREATE INDEX books_title ON books (title);
+CREATE TRIGGER books_insert AFTER INSERT ON books BEGIN
+    UPDATE books SET title = upper(title) WHERE id = new.id;
+END;
+
+DROP VIEW author_names;
+DROP VIEW IF EXISTS missing_view;
+DROP INDEX books_title;
+DROP INDEX IF EXISTS main.missing_index;
+DROP TRIGGER books_insert;
+DROP TRIGGER IF EXISTS missing_trigger;
+
+ALTER TABLE Books RENAME TO Novels;
+ALTER TABLE novels RENAME COLUMN Title TO Name;
+ALTER TABLE novels ADD COLUMN author_id INTEGER NOT NULL REFERENCES "Authors" (id);
+ALTER TABLE novels ADD COLUMN notes;
+ALTER TABLE "Authors" DROP COLUMN name;
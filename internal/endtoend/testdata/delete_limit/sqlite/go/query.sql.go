@@ -0,0 +1,69 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const deleteEvents = `-- name: DeleteEvents :execrows
+DELETE FROM events WHERE created_at < ?1 LIMIT ?2
+`
+
+type DeleteEventsParams struct {
+	Before  int64
+	MaxRows int64
+}
+
+func (q *Queries) DeleteEvents(ctx context.Context, arg DeleteEventsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteEvents, arg.Before, arg.MaxRows)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteOldestEvents = `-- name: DeleteOldestEvents :many
+DELETE FROM events
+WHERE kind = ?
+ORDER BY created_at
+LIMIT ? OFFSET ?
+RETURNING id, created_at
+`
+
+type DeleteOldestEventsParams struct {
+	Kind   string
+	Limit  int64
+	Offset int64
+}
+
+type DeleteOldestEventsRow struct {
+	ID        int64
+	CreatedAt int64
+}
+
+func (q *Queries) DeleteOldestEvents(ctx context.Context, arg DeleteOldestEventsParams) ([]DeleteOldestEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, deleteOldestEvents, arg.Kind, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeleteOldestEventsRow
+	for rows.Next() {
+		var i DeleteOldestEventsRow
+		if err := rows.Scan(&i.ID, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,9 @@
+-- name: DeleteOldestEvents :many
+DELETE FROM events
+WHERE kind = ?
+ORDER BY created_at
+LIMIT ? OFFSET ?
+RETURNING id, created_at;
+
+-- name: DeleteEvents :execrows
+DELETE FROM events WHERE created_at < @before LIMIT @max_rows;
@@ -0,0 +1,66 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (email, quota, labels) VALUES ($1, $2, $3) RETURNING id, email, backup_email, quota, labels, created
+`
+
+type CreateUserParams struct {
+	Email  Email
+	Quota  *PositiveInt
+	Labels []string
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createUser, arg.Email, arg.Quota, arg.Labels)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.BackupEmail,
+		&i.Quota,
+		&i.Labels,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getUser = `-- name: GetUser :one
+SELECT id, email, backup_email, quota, labels, created FROM users WHERE email = $1
+`
+
+func (q *Queries) GetUser(ctx context.Context, email Email) (User, error) {
+	row := q.db.QueryRow(ctx, getUser, email)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.BackupEmail,
+		&i.Quota,
+		&i.Labels,
+		&i.Created,
+	)
+	return i, err
+}
+
+const setQuota = `-- name: SetQuota :exec
+UPDATE users SET quota = $2::positive_int WHERE id = $1
+`
+
+type SetQuotaParams struct {
+	ID    int64
+	Quota PositiveInt
+}
+
+func (q *Queries) SetQuota(ctx context.Context, arg SetQuotaParams) error {
+	_, err := q.db.Exec(ctx, setQuota, arg.ID, arg.Quota)
+	return err
+}
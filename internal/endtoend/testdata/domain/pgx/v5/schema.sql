@@ -0,0 +1,16 @@
+CREATE DOMAIN email AS text NOT NULL CHECK (VALUE ~ '^[^@]+@[^@]+$');
+CREATE DOMAIN contact_email AS email;
+CREATE DOMAIN positive_int AS integer DEFAULT 1 CHECK (VALUE > 0);
+CREATE DOMAIN tags AS text[];
+CREATE DOMAIN created_at AS timestamptz NOT NULL DEFAULT now();
+
+COMMENT ON DOMAIN email IS 'An email address';
+
+CREATE TABLE users (
+    id bigserial PRIMARY KEY,
+    email email,
+    backup_email contact_email,
+    quota positive_int,
+    labels tags NOT NULL,
+    created created_at
+);
@@ -0,0 +1,8 @@
+-- name: GetUser :one
+SELECT * FROM users WHERE email = $1;
+
+-- name: CreateUser :one
+INSERT INTO users (email, quota, labels) VALUES ($1, $2, $3) RETURNING *;
+
+-- name: SetQuota :exec
+UPDATE users SET quota = sqlc.arg(quota)::positive_int WHERE id = $1;
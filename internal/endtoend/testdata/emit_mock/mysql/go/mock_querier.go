@@ -0,0 +1,115 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+
+package querytest
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MockQuerier is an implementation of Querier for use in tests. Each method
+// records its arguments, which are returned by the method's Calls method, and
+// returns the result of calling the method's Func field, which must be set
+// before the method is called.
+type MockQuerier struct {
+	mu sync.Mutex
+
+	CreateUserFunc             func(ctx context.Context, db DBTX, arg CreateUserParams) (int64, error)
+	createUserCalls            []MockQuerierCreateUserCall
+	GetUserFunc                func(ctx context.Context, db DBTX, id int32) (User, error)
+	getUserCalls               []MockQuerierGetUserCall
+	ListUsersCreatedAfterFunc  func(ctx context.Context, db DBTX, createdAt time.Time) ([]User, error)
+	listUsersCreatedAfterCalls []MockQuerierListUsersCreatedAfterCall
+}
+
+// MockQuerierCreateUserCall holds the arguments of a call to MockQuerier.CreateUser
+type MockQuerierCreateUserCall struct {
+	Ctx context.Context
+	DB  DBTX
+	Arg CreateUserParams
+}
+
+func (m *MockQuerier) CreateUser(ctx context.Context, db DBTX, arg CreateUserParams) (int64, error) {
+	m.mu.Lock()
+	m.createUserCalls = append(m.createUserCalls, MockQuerierCreateUserCall{
+		Ctx: ctx,
+		DB:  db,
+		Arg: arg,
+	})
+	fn := m.CreateUserFunc
+	m.mu.Unlock()
+	if fn == nil {
+		panic("MockQuerier: CreateUserFunc is not set")
+	}
+	return fn(ctx, db, arg)
+}
+
+// CreateUserCalls returns the arguments of each call to CreateUser, in order
+func (m *MockQuerier) CreateUserCalls() []MockQuerierCreateUserCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockQuerierCreateUserCall(nil), m.createUserCalls...)
+}
+
+// MockQuerierGetUserCall holds the arguments of a call to MockQuerier.GetUser
+type MockQuerierGetUserCall struct {
+	Ctx context.Context
+	DB  DBTX
+	ID  int32
+}
+
+func (m *MockQuerier) GetUser(ctx context.Context, db DBTX, id int32) (User, error) {
+	m.mu.Lock()
+	m.getUserCalls = append(m.getUserCalls, MockQuerierGetUserCall{
+		Ctx: ctx,
+		DB:  db,
+		ID:  id,
+	})
+	fn := m.GetUserFunc
+	m.mu.Unlock()
+	if fn == nil {
+		panic("MockQuerier: GetUserFunc is not set")
+	}
+	return fn(ctx, db, id)
+}
+
+// GetUserCalls returns the arguments of each call to GetUser, in order
+func (m *MockQuerier) GetUserCalls() []MockQuerierGetUserCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockQuerierGetUserCall(nil), m.getUserCalls...)
+}
+
+// MockQuerierListUsersCreatedAfterCall holds the arguments of a call to MockQuerier.ListUsersCreatedAfter
+type MockQuerierListUsersCreatedAfterCall struct {
+	Ctx       context.Context
+	DB        DBTX
+	CreatedAt time.Time
+}
+
+func (m *MockQuerier) ListUsersCreatedAfter(ctx context.Context, db DBTX, createdAt time.Time) ([]User, error) {
+	m.mu.Lock()
+	m.listUsersCreatedAfterCalls = append(m.listUsersCreatedAfterCalls, MockQuerierListUsersCreatedAfterCall{
+		Ctx:       ctx,
+		DB:        db,
+		CreatedAt: createdAt,
+	})
+	fn := m.ListUsersCreatedAfterFunc
+	m.mu.Unlock()
+	if fn == nil {
+		panic("MockQuerier: ListUsersCreatedAfterFunc is not set")
+	}
+	return fn(ctx, db, createdAt)
+}
+
+// ListUsersCreatedAfterCalls returns the arguments of each call to ListUsersCreatedAfter, in order
+func (m *MockQuerier) ListUsersCreatedAfterCalls() []MockQuerierListUsersCreatedAfterCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockQuerierListUsersCreatedAfterCall(nil), m.listUsersCreatedAfterCalls...)
+}
+
+var _ Querier = (*MockQuerier)(nil)
@@ -0,0 +1,18 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+
+package querytest
+
+import (
+	"context"
+	"time"
+)
+
+type Querier interface {
+	CreateUser(ctx context.Context, db DBTX, arg CreateUserParams) (int64, error)
+	GetUser(ctx context.Context, db DBTX, id int32) (User, error)
+	ListUsersCreatedAfter(ctx context.Context, db DBTX, createdAt time.Time) ([]User, error)
+}
+
+var _ Querier = (*Queries)(nil)
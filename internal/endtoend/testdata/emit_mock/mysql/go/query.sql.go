@@ -0,0 +1,77 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createUser = `-- name: CreateUser :execlastid
+INSERT INTO users (first_name, last_name) VALUES (?, ?)
+`
+
+type CreateUserParams struct {
+	FirstName string
+	LastName  sql.NullString
+}
+
+func (q *Queries) CreateUser(ctx context.Context, db DBTX, arg CreateUserParams) (int64, error) {
+	result, err := db.ExecContext(ctx, createUser, arg.FirstName, arg.LastName)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const getUser = `-- name: GetUser :one
+SELECT id, first_name, last_name, created_at FROM users WHERE id = ?
+`
+
+func (q *Queries) GetUser(ctx context.Context, db DBTX, id int32) (User, error) {
+	row := db.QueryRowContext(ctx, getUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listUsersCreatedAfter = `-- name: ListUsersCreatedAfter :many
+SELECT id, first_name, last_name, created_at FROM users WHERE created_at > ?
+`
+
+func (q *Queries) ListUsersCreatedAfter(ctx context.Context, db DBTX, createdAt time.Time) ([]User, error) {
+	rows, err := db.QueryContext(ctx, listUsersCreatedAfter, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,8 @@
+/* name: GetUser :one */
+SELECT * FROM users WHERE id = ?;
+
+/* name: ListUsersCreatedAfter :many */
+SELECT * FROM users WHERE created_at > ?;
+
+/* name: CreateUser :execlastid */
+INSERT INTO users (first_name, last_name) VALUES (?, ?);
@@ -0,0 +1,6 @@
+CREATE TABLE users (
+    id integer NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    first_name varchar(255) NOT NULL,
+    last_name varchar(255),
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
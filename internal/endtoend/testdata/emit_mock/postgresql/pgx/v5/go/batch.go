@@ -0,0 +1,63 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: batch.go
+
+package querytest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrBatchAlreadyClosed = errors.New("batch already closed")
+)
+
+const getAuthorsBatch = `-- name: GetAuthorsBatch :batchone
+SELECT id, name, bio FROM authors
+WHERE id = $1
+`
+
+type GetAuthorsBatchBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+func (q *Queries) GetAuthorsBatch(ctx context.Context, id []int64) *GetAuthorsBatchBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range id {
+		vals := []interface{}{
+			a,
+		}
+		batch.Queue(getAuthorsBatch, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &GetAuthorsBatchBatchResults{br, len(id), false}
+}
+
+func (b *GetAuthorsBatchBatchResults) QueryRow(f func(int, Author, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var i Author
+		if b.closed {
+			if f != nil {
+				f(t, i, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		row := b.br.QueryRow()
+		err := row.Scan(&i.ID, &i.Name, &i.Bio)
+		if f != nil {
+			f(t, i, err)
+		}
+	}
+}
+
+func (b *GetAuthorsBatchBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
@@ -0,0 +1,251 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+
+package querytest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// MockQuerier is an implementation of Querier for use in tests. Each method
+// records its arguments, which are returned by the method's Calls method, and
+// returns the result of calling the method's Func field, which must be set
+// before the method is called.
+type MockQuerier struct {
+	mu sync.Mutex
+
+	CreateAuthorFunc      func(ctx context.Context, arg CreateAuthorParams) (Author, error)
+	createAuthorCalls     []MockQuerierCreateAuthorCall
+	CreateAuthorsFunc     func(ctx context.Context, arg []CreateAuthorsParams) (int64, error)
+	createAuthorsCalls    []MockQuerierCreateAuthorsCall
+	DeleteAllAuthorsFunc  func(ctx context.Context) (pgconn.CommandTag, error)
+	deleteAllAuthorsCalls []MockQuerierDeleteAllAuthorsCall
+	DeleteAuthorFunc      func(ctx context.Context, id int64) error
+	deleteAuthorCalls     []MockQuerierDeleteAuthorCall
+	GetAuthorFunc         func(ctx context.Context, id int64) (Author, error)
+	getAuthorCalls        []MockQuerierGetAuthorCall
+	GetAuthorsBatchFunc   func(ctx context.Context, id []int64) *GetAuthorsBatchBatchResults
+	getAuthorsBatchCalls  []MockQuerierGetAuthorsBatchCall
+	ListAuthorsFunc       func(ctx context.Context) ([]Author, error)
+	listAuthorsCalls      []MockQuerierListAuthorsCall
+	UpdateAuthorBioFunc   func(ctx context.Context, arg UpdateAuthorBioParams) (int64, error)
+	updateAuthorBioCalls  []MockQuerierUpdateAuthorBioCall
+}
+
+// MockQuerierCreateAuthorCall holds the arguments of a call to MockQuerier.CreateAuthor
+type MockQuerierCreateAuthorCall struct {
+	Ctx context.Context
+	Arg CreateAuthorParams
+}
+
+func (m *MockQuerier) CreateAuthor(ctx context.Context, arg CreateAuthorParams) (Author, error) {
+	m.mu.Lock()
+	m.createAuthorCalls = append(m.createAuthorCalls, MockQuerierCreateAuthorCall{
+		Ctx: ctx,
+		Arg: arg,
+	})
+	fn := m.CreateAuthorFunc
+	m.mu.Unlock()
+	if fn == nil {
+		panic("MockQuerier: CreateAuthorFunc is not set")
+	}
+	return fn(ctx, arg)
+}
+
+// CreateAuthorCalls returns the arguments of each call to CreateAuthor, in order
+func (m *MockQuerier) CreateAuthorCalls() []MockQuerierCreateAuthorCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockQuerierCreateAuthorCall(nil), m.createAuthorCalls...)
+}
+
+// MockQuerierCreateAuthorsCall holds the arguments of a call to MockQuerier.CreateAuthors
+type MockQuerierCreateAuthorsCall struct {
+	Ctx context.Context
+	Arg []CreateAuthorsParams
+}
+
+func (m *MockQuerier) CreateAuthors(ctx context.Context, arg []CreateAuthorsParams) (int64, error) {
+	m.mu.Lock()
+	m.createAuthorsCalls = append(m.createAuthorsCalls, MockQuerierCreateAuthorsCall{
+		Ctx: ctx,
+		Arg: arg,
+	})
+	fn := m.CreateAuthorsFunc
+	m.mu.Unlock()
+	if fn == nil {
+		panic("MockQuerier: CreateAuthorsFunc is not set")
+	}
+	return fn(ctx, arg)
+}
+
+// CreateAuthorsCalls returns the arguments of each call to CreateAuthors, in order
+func (m *MockQuerier) CreateAuthorsCalls() []MockQuerierCreateAuthorsCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockQuerierCreateAuthorsCall(nil), m.createAuthorsCalls...)
+}
+
+// MockQuerierDeleteAllAuthorsCall holds the arguments of a call to MockQuerier.DeleteAllAuthors
+type MockQuerierDeleteAllAuthorsCall struct {
+	Ctx context.Context
+}
+
+func (m *MockQuerier) DeleteAllAuthors(ctx context.Context) (pgconn.CommandTag, error) {
+	m.mu.Lock()
+	m.deleteAllAuthorsCalls = append(m.deleteAllAuthorsCalls, MockQuerierDeleteAllAuthorsCall{
+		Ctx: ctx,
+	})
+	fn := m.DeleteAllAuthorsFunc
+	m.mu.Unlock()
+	if fn == nil {
+		panic("MockQuerier: DeleteAllAuthorsFunc is not set")
+	}
+	return fn(ctx)
+}
+
+// DeleteAllAuthorsCalls returns the arguments of each call to DeleteAllAuthors, in order
+func (m *MockQuerier) DeleteAllAuthorsCalls() []MockQuerierDeleteAllAuthorsCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockQuerierDeleteAllAuthorsCall(nil), m.deleteAllAuthorsCalls...)
+}
+
+// MockQuerierDeleteAuthorCall holds the arguments of a call to MockQuerier.DeleteAuthor
+type MockQuerierDeleteAuthorCall struct {
+	Ctx context.Context
+	ID  int64
+}
+
+func (m *MockQuerier) DeleteAuthor(ctx context.Context, id int64) error {
+	m.mu.Lock()
+	m.deleteAuthorCalls = append(m.deleteAuthorCalls, MockQuerierDeleteAuthorCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.DeleteAuthorFunc
+	m.mu.Unlock()
+	if fn == nil {
+		panic("MockQuerier: DeleteAuthorFunc is not set")
+	}
+	return fn(ctx, id)
+}
+
+// DeleteAuthorCalls returns the arguments of each call to DeleteAuthor, in order
+func (m *MockQuerier) DeleteAuthorCalls() []MockQuerierDeleteAuthorCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockQuerierDeleteAuthorCall(nil), m.deleteAuthorCalls...)
+}
+
+// MockQuerierGetAuthorCall holds the arguments of a call to MockQuerier.GetAuthor
+type MockQuerierGetAuthorCall struct {
+	Ctx context.Context
+	ID  int64
+}
+
+func (m *MockQuerier) GetAuthor(ctx context.Context, id int64) (Author, error) {
+	m.mu.Lock()
+	m.getAuthorCalls = append(m.getAuthorCalls, MockQuerierGetAuthorCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.GetAuthorFunc
+	m.mu.Unlock()
+	if fn == nil {
+		panic("MockQuerier: GetAuthorFunc is not set")
+	}
+	return fn(ctx, id)
+}
+
+// GetAuthorCalls returns the arguments of each call to GetAuthor, in order
+func (m *MockQuerier) GetAuthorCalls() []MockQuerierGetAuthorCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockQuerierGetAuthorCall(nil), m.getAuthorCalls...)
+}
+
+// MockQuerierGetAuthorsBatchCall holds the arguments of a call to MockQuerier.GetAuthorsBatch
+type MockQuerierGetAuthorsBatchCall struct {
+	Ctx context.Context
+	ID  []int64
+}
+
+func (m *MockQuerier) GetAuthorsBatch(ctx context.Context, id []int64) *GetAuthorsBatchBatchResults {
+	m.mu.Lock()
+	m.getAuthorsBatchCalls = append(m.getAuthorsBatchCalls, MockQuerierGetAuthorsBatchCall{
+		Ctx: ctx,
+		ID:  id,
+	})
+	fn := m.GetAuthorsBatchFunc
+	m.mu.Unlock()
+	if fn == nil {
+		panic("MockQuerier: GetAuthorsBatchFunc is not set")
+	}
+	return fn(ctx, id)
+}
+
+// GetAuthorsBatchCalls returns the arguments of each call to GetAuthorsBatch, in order
+func (m *MockQuerier) GetAuthorsBatchCalls() []MockQuerierGetAuthorsBatchCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockQuerierGetAuthorsBatchCall(nil), m.getAuthorsBatchCalls...)
+}
+
+// MockQuerierListAuthorsCall holds the arguments of a call to MockQuerier.ListAuthors
+type MockQuerierListAuthorsCall struct {
+	Ctx context.Context
+}
+
+func (m *MockQuerier) ListAuthors(ctx context.Context) ([]Author, error) {
+	m.mu.Lock()
+	m.listAuthorsCalls = append(m.listAuthorsCalls, MockQuerierListAuthorsCall{
+		Ctx: ctx,
+	})
+	fn := m.ListAuthorsFunc
+	m.mu.Unlock()
+	if fn == nil {
+		panic("MockQuerier: ListAuthorsFunc is not set")
+	}
+	return fn(ctx)
+}
+
+// ListAuthorsCalls returns the arguments of each call to ListAuthors, in order
+func (m *MockQuerier) ListAuthorsCalls() []MockQuerierListAuthorsCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockQuerierListAuthorsCall(nil), m.listAuthorsCalls...)
+}
+
+// MockQuerierUpdateAuthorBioCall holds the arguments of a call to MockQuerier.UpdateAuthorBio
+type MockQuerierUpdateAuthorBioCall struct {
+	Ctx context.Context
+	Arg UpdateAuthorBioParams
+}
+
+func (m *MockQuerier) UpdateAuthorBio(ctx context.Context, arg UpdateAuthorBioParams) (int64, error) {
+	m.mu.Lock()
+	m.updateAuthorBioCalls = append(m.updateAuthorBioCalls, MockQuerierUpdateAuthorBioCall{
+		Ctx: ctx,
+		Arg: arg,
+	})
+	fn := m.UpdateAuthorBioFunc
+	m.mu.Unlock()
+	if fn == nil {
+		panic("MockQuerier: UpdateAuthorBioFunc is not set")
+	}
+	return fn(ctx, arg)
+}
+
+// UpdateAuthorBioCalls returns the arguments of each call to UpdateAuthorBio, in order
+func (m *MockQuerier) UpdateAuthorBioCalls() []MockQuerierUpdateAuthorBioCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockQuerierUpdateAuthorBioCall(nil), m.updateAuthorBioCalls...)
+}
+
+var _ Querier = (*MockQuerier)(nil)
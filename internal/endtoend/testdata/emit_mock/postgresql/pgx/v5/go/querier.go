@@ -0,0 +1,24 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+
+package querytest
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type Querier interface {
+	CreateAuthor(ctx context.Context, arg CreateAuthorParams) (Author, error)
+	CreateAuthors(ctx context.Context, arg []CreateAuthorsParams) (int64, error)
+	DeleteAllAuthors(ctx context.Context) (pgconn.CommandTag, error)
+	DeleteAuthor(ctx context.Context, id int64) error
+	GetAuthor(ctx context.Context, id int64) (Author, error)
+	GetAuthorsBatch(ctx context.Context, id []int64) *GetAuthorsBatchBatchResults
+	ListAuthors(ctx context.Context) ([]Author, error)
+	UpdateAuthorBio(ctx context.Context, arg UpdateAuthorBioParams) (int64, error)
+}
+
+var _ Querier = (*Queries)(nil)
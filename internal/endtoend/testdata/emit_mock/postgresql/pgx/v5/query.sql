@@ -0,0 +1,33 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: CreateAuthor :one
+INSERT INTO authors (
+  name, bio
+) VALUES (
+  $1, $2
+)
+RETURNING *;
+
+-- name: UpdateAuthorBio :execrows
+UPDATE authors SET bio = $2
+WHERE id = $1;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = $1;
+
+-- name: DeleteAllAuthors :execresult
+DELETE FROM authors;
+
+-- name: CreateAuthors :copyfrom
+INSERT INTO authors (name, bio) VALUES ($1, $2);
+
+-- name: GetAuthorsBatch :batchone
+SELECT * FROM authors
+WHERE id = $1;
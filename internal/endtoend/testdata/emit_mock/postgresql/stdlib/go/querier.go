@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+type Querier interface {
+	CreateAuthor(ctx context.Context, arg CreateAuthorParams) (Author, error)
+	DeleteAllAuthors(ctx context.Context) (sql.Result, error)
+	DeleteAuthor(ctx context.Context, id int64) error
+	GetAuthor(ctx context.Context, id int64) (Author, error)
+	ListAuthors(ctx context.Context) ([]Author, error)
+	UpdateAuthorBio(ctx context.Context, arg UpdateAuthorBioParams) (int64, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const getAuthor = `-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetAuthor(ctx context.Context, id int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthor, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const updateAuthorBio = `-- name: UpdateAuthorBio :exec
+UPDATE authors SET bio = $2
+WHERE id = $1
+`
+
+type UpdateAuthorBioParams struct {
+	ID  int64
+	Bio sql.NullString
+}
+
+func (q *Queries) UpdateAuthorBio(ctx context.Context, arg UpdateAuthorBioParams) error {
+	_, err := q.db.ExecContext(ctx, updateAuthorBio, arg.ID, arg.Bio)
+	return err
+}
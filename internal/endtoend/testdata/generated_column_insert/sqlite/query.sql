@@ -0,0 +1,6 @@
+-- name: CreateProduct :exec
+INSERT INTO products (price, quantity, total)
+VALUES (?, ?, ?);
+
+-- name: SetTotal :exec
+UPDATE products SET total = ? WHERE id = ?;
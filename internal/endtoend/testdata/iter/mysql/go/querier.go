@@ -0,0 +1,19 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"iter"
+)
+
+type Querier interface {
+	IterAuthorNames(ctx context.Context, db DBTX, bio sql.NullString) iter.Seq2[string, error]
+	IterAuthors(ctx context.Context, db DBTX) iter.Seq2[Author, error]
+	IterAuthorsByIDs(ctx context.Context, db DBTX, ids []int64) iter.Seq2[IterAuthorsByIDsRow, error]
+}
+
+var _ Querier = (*Queries)(nil)
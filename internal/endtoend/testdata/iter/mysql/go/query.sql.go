@@ -0,0 +1,130 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"iter"
+	"strings"
+)
+
+const iterAuthorNames = `-- name: IterAuthorNames :iter
+SELECT name FROM authors
+WHERE bio = ?
+`
+
+func (q *Queries) IterAuthorNames(ctx context.Context, db DBTX, bio sql.NullString) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		rows, err := db.QueryContext(ctx, iterAuthorNames, bio)
+		var zero string
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(name, nil) {
+				return
+			}
+		}
+		if err := rows.Close(); err != nil {
+			yield(zero, err)
+			return
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+const iterAuthors = `-- name: IterAuthors :iter
+SELECT id, name, bio FROM authors
+ORDER BY name
+`
+
+func (q *Queries) IterAuthors(ctx context.Context, db DBTX) iter.Seq2[Author, error] {
+	return func(yield func(Author, error) bool) {
+		rows, err := db.QueryContext(ctx, iterAuthors)
+		var zero Author
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var i Author
+			if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(i, nil) {
+				return
+			}
+		}
+		if err := rows.Close(); err != nil {
+			yield(zero, err)
+			return
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+const iterAuthorsByIDs = `-- name: IterAuthorsByIDs :iter
+SELECT id, name FROM authors
+WHERE id IN (/*SLICE:ids*/?)
+`
+
+type IterAuthorsByIDsRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) IterAuthorsByIDs(ctx context.Context, db DBTX, ids []int64) iter.Seq2[IterAuthorsByIDsRow, error] {
+	return func(yield func(IterAuthorsByIDsRow, error) bool) {
+		query := iterAuthorsByIDs
+		var queryParams []interface{}
+		if len(ids) > 0 {
+			for _, v := range ids {
+				queryParams = append(queryParams, v)
+			}
+			query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+		} else {
+			query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+		}
+		rows, err := db.QueryContext(ctx, query, queryParams...)
+		var zero IterAuthorsByIDsRow
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var i IterAuthorsByIDsRow
+			if err := rows.Scan(&i.ID, &i.Name); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(i, nil) {
+				return
+			}
+		}
+		if err := rows.Close(); err != nil {
+			yield(zero, err)
+			return
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
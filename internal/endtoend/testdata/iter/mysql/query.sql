@@ -0,0 +1,11 @@
+-- name: IterAuthors :iter
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: IterAuthorNames :iter
+SELECT name FROM authors
+WHERE bio = ?;
+
+-- name: IterAuthorsByIDs :iter
+SELECT id, name FROM authors
+WHERE id IN (sqlc.slice(ids));
@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+
+package querytest
+
+import (
+	"context"
+	"iter"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Querier interface {
+	DeleteAuthorsReturning(ctx context.Context, name string) iter.Seq2[Author, error]
+	IterAuthorNames(ctx context.Context, bio pgtype.Text) iter.Seq2[string, error]
+	IterAuthors(ctx context.Context) iter.Seq2[Author, error]
+	IterAuthorsByIDs(ctx context.Context, dollar_1 []int64) iter.Seq2[IterAuthorsByIDsRow, error]
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,139 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"iter"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteAuthorsReturning = `-- name: DeleteAuthorsReturning :iter
+DELETE FROM authors
+WHERE name = $1
+RETURNING id, name, bio
+`
+
+func (q *Queries) DeleteAuthorsReturning(ctx context.Context, name string) iter.Seq2[Author, error] {
+	return func(yield func(Author, error) bool) {
+		rows, err := q.db.Query(ctx, deleteAuthorsReturning, name)
+		var zero Author
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var i Author
+			if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(i, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+const iterAuthorNames = `-- name: IterAuthorNames :iter
+SELECT name FROM authors
+WHERE bio = $1
+`
+
+func (q *Queries) IterAuthorNames(ctx context.Context, bio pgtype.Text) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		rows, err := q.db.Query(ctx, iterAuthorNames, bio)
+		var zero string
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(name, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+const iterAuthors = `-- name: IterAuthors :iter
+SELECT id, name, bio FROM authors
+ORDER BY name
+`
+
+func (q *Queries) IterAuthors(ctx context.Context) iter.Seq2[Author, error] {
+	return func(yield func(Author, error) bool) {
+		rows, err := q.db.Query(ctx, iterAuthors)
+		var zero Author
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var i Author
+			if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(i, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+const iterAuthorsByIDs = `-- name: IterAuthorsByIDs :iter
+SELECT id, name FROM authors
+WHERE id = ANY($1::bigint[])
+`
+
+type IterAuthorsByIDsRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) IterAuthorsByIDs(ctx context.Context, dollar_1 []int64) iter.Seq2[IterAuthorsByIDsRow, error] {
+	return func(yield func(IterAuthorsByIDsRow, error) bool) {
+		rows, err := q.db.Query(ctx, iterAuthorsByIDs, dollar_1)
+		var zero IterAuthorsByIDsRow
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var i IterAuthorsByIDsRow
+			if err := rows.Scan(&i.ID, &i.Name); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(i, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
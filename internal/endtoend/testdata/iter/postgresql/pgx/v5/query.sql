@@ -0,0 +1,16 @@
+-- name: IterAuthors :iter
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: IterAuthorNames :iter
+SELECT name FROM authors
+WHERE bio = $1;
+
+-- name: IterAuthorsByIDs :iter
+SELECT id, name FROM authors
+WHERE id = ANY($1::bigint[]);
+
+-- name: DeleteAuthorsReturning :iter
+DELETE FROM authors
+WHERE name = $1
+RETURNING *;
@@ -0,0 +1,115 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"iter"
+)
+
+const deleteAuthorsReturning = `-- name: DeleteAuthorsReturning :iter
+DELETE FROM authors
+WHERE name = ?
+RETURNING id, name, bio
+`
+
+func (q *Queries) DeleteAuthorsReturning(ctx context.Context, name string) iter.Seq2[*Author, error] {
+	return func(yield func(*Author, error) bool) {
+		rows, err := q.db.QueryContext(ctx, deleteAuthorsReturning, name)
+		var zero *Author
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var i Author
+			if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(&i, nil) {
+				return
+			}
+		}
+		if err := rows.Close(); err != nil {
+			yield(zero, err)
+			return
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+const iterAuthorNames = `-- name: IterAuthorNames :iter
+SELECT name FROM authors
+WHERE bio = ?
+`
+
+func (q *Queries) IterAuthorNames(ctx context.Context, bio sql.NullString) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		rows, err := q.db.QueryContext(ctx, iterAuthorNames, bio)
+		var zero string
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(name, nil) {
+				return
+			}
+		}
+		if err := rows.Close(); err != nil {
+			yield(zero, err)
+			return
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
+
+const iterAuthors = `-- name: IterAuthors :iter
+SELECT id, name, bio FROM authors
+ORDER BY name
+`
+
+func (q *Queries) IterAuthors(ctx context.Context) iter.Seq2[*Author, error] {
+	return func(yield func(*Author, error) bool) {
+		rows, err := q.db.QueryContext(ctx, iterAuthors)
+		var zero *Author
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var i Author
+			if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(&i, nil) {
+				return
+			}
+		}
+		if err := rows.Close(); err != nil {
+			yield(zero, err)
+			return
+		}
+		if err := rows.Err(); err != nil {
+			yield(zero, err)
+		}
+	}
+}
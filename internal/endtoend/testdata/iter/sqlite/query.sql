@@ -0,0 +1,12 @@
+-- name: IterAuthors :iter
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: IterAuthorNames :iter
+SELECT name FROM authors
+WHERE bio = ?;
+
+-- name: DeleteAuthorsReturning :iter
+DELETE FROM authors
+WHERE name = ?
+RETURNING *;
@@ -0,0 +1,17 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+
+package querytest
+
+import (
+	"context"
+)
+
+type Querier interface {
+	ListAuthorIDs(ctx context.Context, arg ListAuthorIDsParams) ([]int64, *ListAuthorIDsParams, error)
+	ListAuthors(ctx context.Context, arg ListAuthorsParams) ([]Author, *ListAuthorsParams, error)
+	ListAuthorsByBio(ctx context.Context, arg ListAuthorsByBioParams) ([]ListAuthorsByBioRow, *ListAuthorsByBioParams, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,155 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const listAuthorIDs = `-- name: ListAuthorIDs :many
+SELECT a.id FROM authors a
+WHERE (a.id > $1 OR $1 IS NULL)
+ORDER BY a.id
+LIMIT $2
+`
+
+type ListAuthorIDsParams struct {
+	AfterID  sql.NullInt64
+	PageSize int32
+}
+
+func (q *Queries) ListAuthorIDs(ctx context.Context, arg ListAuthorIDsParams) ([]int64, *ListAuthorIDsParams, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorIDs, arg.AfterID, arg.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(items) == 0 || len(items) < int(arg.PageSize) {
+		return items, nil, nil
+	}
+	last := items[len(items)-1]
+	next := arg
+	if err := next.AfterID.Scan(last); err != nil {
+		return nil, nil, err
+	}
+	return items, &next, nil
+}
+
+const listAuthors = `-- name: ListAuthors :many
+SELECT id, name, bio FROM authors
+WHERE (id > $1 OR $1 IS NULL)
+ORDER BY id
+LIMIT $2
+`
+
+type ListAuthorsParams struct {
+	AfterID  sql.NullInt64
+	PageSize int32
+}
+
+func (q *Queries) ListAuthors(ctx context.Context, arg ListAuthorsParams) ([]Author, *ListAuthorsParams, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthors, arg.AfterID, arg.PageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return nil, nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(items) == 0 || len(items) < int(arg.PageSize) {
+		return items, nil, nil
+	}
+	last := items[len(items)-1]
+	next := arg
+	if err := next.AfterID.Scan(last.ID); err != nil {
+		return nil, nil, err
+	}
+	return items, &next, nil
+}
+
+const listAuthorsByBio = `-- name: ListAuthorsByBio :many
+SELECT id, name FROM authors
+WHERE bio = $1 AND ((name, id) > ($2, $3) OR $2 IS NULL)
+ORDER BY name, id
+LIMIT $4
+`
+
+type ListAuthorsByBioParams struct {
+	Bio       string
+	AfterName sql.NullString
+	AfterID   sql.NullInt64
+	PageSize  int32
+}
+
+type ListAuthorsByBioRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) ListAuthorsByBio(ctx context.Context, arg ListAuthorsByBioParams) ([]ListAuthorsByBioRow, *ListAuthorsByBioParams, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorsByBio,
+		arg.Bio,
+		arg.AfterName,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	var items []ListAuthorsByBioRow
+	for rows.Next() {
+		var i ListAuthorsByBioRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(items) == 0 || len(items) < int(arg.PageSize) {
+		return items, nil, nil
+	}
+	last := items[len(items)-1]
+	next := arg
+	if err := next.AfterName.Scan(last.Name); err != nil {
+		return nil, nil, err
+	}
+	if err := next.AfterID.Scan(last.ID); err != nil {
+		return nil, nil, err
+	}
+	return items, &next, nil
+}
@@ -0,0 +1,11 @@
+-- name: ListAuthors :many
+SELECT * FROM authors
+WHERE sqlc.paginate(id);
+
+-- name: ListAuthorsByBio :many
+SELECT id, name FROM authors
+WHERE bio = sqlc.arg(bio) AND sqlc.paginate(name, id);
+
+-- name: ListAuthorIDs :many
+SELECT a.id FROM authors a
+WHERE sqlc.paginate(a.id);
@@ -0,0 +1,16 @@
+-- name: ListAuthors :many
+SELECT * FROM authors
+WHERE sqlc.paginate(id)
+ORDER BY name;
+
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE sqlc.paginate(id);
+
+-- name: ListAuthorsByBio :many
+SELECT id, name FROM authors
+WHERE sqlc.paginate(bio);
+
+-- name: ListAuthorsByNickname :many
+SELECT id, nickname FROM authors
+WHERE sqlc.paginate(nickname);
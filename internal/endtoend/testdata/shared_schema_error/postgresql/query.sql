@@ -0,0 +1,3 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE missing = $1 LIMIT 1;
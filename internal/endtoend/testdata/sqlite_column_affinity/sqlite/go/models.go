@@ -0,0 +1,30 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+
+package querytest
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Event struct {
+	ID           int64
+	Title        string
+	Body         sql.NullString
+	Attendees    int64
+	Price        sql.NullFloat64
+	Cover        []byte
+	Payload      interface{}
+	StartsAt     time.Time
+	EndsAt       sql.NullTime
+	DurationDays sql.NullFloat64
+	Slug         sql.NullString
+}
+
+type Tag struct {
+	Name   string
+	Weight float64
+	Extra  interface{}
+}
@@ -0,0 +1,162 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createEvent = `-- name: CreateEvent :one
+INSERT INTO events (title, body, attendees, price, cover, payload, starts_at, ends_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, title, body, attendees, price, cover, payload, starts_at, ends_at, duration_days, slug
+`
+
+type CreateEventParams struct {
+	Title     string
+	Body      sql.NullString
+	Attendees int64
+	Price     sql.NullFloat64
+	Cover     []byte
+	Payload   interface{}
+	StartsAt  time.Time
+	EndsAt    sql.NullTime
+}
+
+func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event, error) {
+	row := q.db.QueryRowContext(ctx, createEvent,
+		arg.Title,
+		arg.Body,
+		arg.Attendees,
+		arg.Price,
+		arg.Cover,
+		arg.Payload,
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Body,
+		&i.Attendees,
+		&i.Price,
+		&i.Cover,
+		&i.Payload,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.DurationDays,
+		&i.Slug,
+	)
+	return i, err
+}
+
+const getEventByRowid = `-- name: GetEventByRowid :one
+SELECT rowid, title, duration_days, slug FROM events
+WHERE rowid = ?
+`
+
+type GetEventByRowidRow struct {
+	Rowid        int64
+	Title        string
+	DurationDays sql.NullFloat64
+	Slug         sql.NullString
+}
+
+func (q *Queries) GetEventByRowid(ctx context.Context, rowid int64) (GetEventByRowidRow, error) {
+	row := q.db.QueryRowContext(ctx, getEventByRowid, rowid)
+	var i GetEventByRowidRow
+	err := row.Scan(
+		&i.Rowid,
+		&i.Title,
+		&i.DurationDays,
+		&i.Slug,
+	)
+	return i, err
+}
+
+const listEventsStartingAfter = `-- name: ListEventsStartingAfter :many
+SELECT id, title, body, attendees, price, cover, payload, starts_at, ends_at, duration_days, slug FROM events
+WHERE starts_at > CAST(? AS DATETIME)
+`
+
+func (q *Queries) ListEventsStartingAfter(ctx context.Context, dollar_1 time.Time) ([]Event, error) {
+	rows, err := q.db.QueryContext(ctx, listEventsStartingAfter, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Body,
+			&i.Attendees,
+			&i.Price,
+			&i.Cover,
+			&i.Payload,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.DurationDays,
+			&i.Slug,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTags = `-- name: ListTags :many
+SELECT name, weight, extra FROM tags
+`
+
+func (q *Queries) ListTags(ctx context.Context) ([]Tag, error) {
+	rows, err := q.db.QueryContext(ctx, listTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tag
+	for rows.Next() {
+		var i Tag
+		if err := rows.Scan(&i.Name, &i.Weight, &i.Extra); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateEventTitle = `-- name: UpdateEventTitle :exec
+UPDATE events SET title = ? WHERE id = ?
+`
+
+type UpdateEventTitleParams struct {
+	Title string
+	ID    int64
+}
+
+func (q *Queries) UpdateEventTitle(ctx context.Context, arg UpdateEventTitleParams) error {
+	_, err := q.db.ExecContext(ctx, updateEventTitle, arg.Title, arg.ID)
+	return err
+}
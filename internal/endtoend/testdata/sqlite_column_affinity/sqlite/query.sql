@@ -0,0 +1,18 @@
+-- name: CreateEvent :one
+INSERT INTO events (title, body, attendees, price, cover, payload, starts_at, ends_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetEventByRowid :one
+SELECT rowid, title, duration_days, slug FROM events
+WHERE rowid = ?;
+
+-- name: ListEventsStartingAfter :many
+SELECT * FROM events
+WHERE starts_at > CAST(? AS DATETIME);
+
+-- name: UpdateEventTitle :exec
+UPDATE events SET title = ? WHERE id = ?;
+
+-- name: ListTags :many
+SELECT * FROM tags;
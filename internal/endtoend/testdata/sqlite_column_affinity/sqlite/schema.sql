@@ -0,0 +1,19 @@
+CREATE TABLE events (
+  id            INTEGER PRIMARY KEY,
+  title         VARCHAR(20) NOT NULL,
+  body          LONGTEXT,
+  attendees     INT64 NOT NULL,
+  price         FLOAT8,
+  cover         TINYBLOB,
+  payload       JSON,
+  starts_at     DATETIME NOT NULL,
+  ends_at       TIMESTAMP(3),
+  duration_days REAL GENERATED ALWAYS AS (julianday(ends_at) - julianday(starts_at)) VIRTUAL,
+  slug          TEXT AS (lower(title)) STORED
+);
+
+CREATE TABLE tags (
+  name   TEXT PRIMARY KEY,
+  weight REAL NOT NULL,
+  extra  ANY
+) STRICT, WITHOUT ROWID;
@@ -0,0 +1,146 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const getFromSubquery = `-- name: GetFromSubquery :many
+SELECT a.id, a.born FROM (SELECT id, born FROM authors) a WHERE a.id > ?
+`
+
+type GetFromSubqueryRow struct {
+	ID   int64
+	Born sql.NullTime
+}
+
+func (q *Queries) GetFromSubquery(ctx context.Context, id int64) ([]GetFromSubqueryRow, error) {
+	rows, err := q.db.QueryContext(ctx, getFromSubquery, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFromSubqueryRow
+	for rows.Next() {
+		var i GetFromSubqueryRow
+		if err := rows.Scan(&i.ID, &i.Born); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorsWithBooks = `-- name: ListAuthorsWithBooks :many
+SELECT authors.name, books.title FROM authors LEFT JOIN books ON books.author_id = authors.id
+`
+
+type ListAuthorsWithBooksRow struct {
+	Name  string
+	Title sql.NullString
+}
+
+func (q *Queries) ListAuthorsWithBooks(ctx context.Context) ([]ListAuthorsWithBooksRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorsWithBooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuthorsWithBooksRow
+	for rows.Next() {
+		var i ListAuthorsWithBooksRow
+		if err := rows.Scan(&i.Name, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCorrelated = `-- name: ListCorrelated :many
+SELECT a.id, (SELECT b.born FROM authors b WHERE b.id = a.id) AS born_copy FROM authors a
+`
+
+type ListCorrelatedRow struct {
+	ID       int64
+	BornCopy sql.NullTime
+}
+
+func (q *Queries) ListCorrelated(ctx context.Context) ([]ListCorrelatedRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCorrelated)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCorrelatedRow
+	for rows.Next() {
+		var i ListCorrelatedRow
+		if err := rows.Scan(&i.ID, &i.BornCopy); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnion = `-- name: ListUnion :many
+SELECT id, born FROM authors WHERE name = ?
+UNION ALL
+SELECT id, born FROM authors WHERE bio = ?
+`
+
+type ListUnionParams struct {
+	Name string
+	Bio  sql.NullString
+}
+
+type ListUnionRow struct {
+	ID   int64
+	Born sql.NullTime
+}
+
+func (q *Queries) ListUnion(ctx context.Context, arg ListUnionParams) ([]ListUnionRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUnion, arg.Name, arg.Bio)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUnionRow
+	for rows.Next() {
+		var i ListUnionRow
+		if err := rows.Scan(&i.ID, &i.Born); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,13 @@
+-- name: GetFromSubquery :many
+SELECT a.id, a.born FROM (SELECT id, born FROM authors) a WHERE a.id > ?;
+
+-- name: ListUnion :many
+SELECT id, born FROM authors WHERE name = ?
+UNION ALL
+SELECT id, born FROM authors WHERE bio = ?;
+
+-- name: ListCorrelated :many
+SELECT a.id, (SELECT b.born FROM authors b WHERE b.id = a.id) AS born_copy FROM authors a;
+
+-- name: ListAuthorsWithBooks :many
+SELECT authors.name, books.title FROM authors LEFT JOIN books ON books.author_id = authors.id;
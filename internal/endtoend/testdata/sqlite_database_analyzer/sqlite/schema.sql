@@ -0,0 +1,12 @@
+CREATE TABLE authors (
+  id   INTEGER PRIMARY KEY,
+  name TEXT NOT NULL,
+  bio  TEXT,
+  born DATETIME
+);
+
+CREATE TABLE books (
+  id        INTEGER PRIMARY KEY,
+  author_id INTEGER NOT NULL REFERENCES authors (id),
+  title     TEXT NOT NULL
+);
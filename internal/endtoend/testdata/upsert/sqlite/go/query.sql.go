@@ -9,6 +9,57 @@ import (
 	"context"
 )
 
+const insertLocationIfMissing = `-- name: InsertLocationIfMissing :many
+INSERT INTO locations (name, address, zip_code, latitude, longitude)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT DO NOTHING
+RETURNING id, name, address, zip_code, latitude, longitude
+`
+
+type InsertLocationIfMissingParams struct {
+	Name      string
+	Address   string
+	ZipCode   int64
+	Latitude  float64
+	Longitude float64
+}
+
+func (q *Queries) InsertLocationIfMissing(ctx context.Context, arg InsertLocationIfMissingParams) ([]Location, error) {
+	rows, err := q.db.QueryContext(ctx, insertLocationIfMissing,
+		arg.Name,
+		arg.Address,
+		arg.ZipCode,
+		arg.Latitude,
+		arg.Longitude,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Location
+	for rows.Next() {
+		var i Location
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Address,
+			&i.ZipCode,
+			&i.Latitude,
+			&i.Longitude,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertLocation = `-- name: UpsertLocation :exec
 INSERT INTO locations (
     name,
@@ -44,3 +95,38 @@ func (q *Queries) UpsertLocation(ctx context.Context, arg UpsertLocationParams)
 	)
 	return err
 }
+
+const upsertLocationReturningID = `-- name: UpsertLocationReturningID :one
+INSERT INTO locations (name, address, zip_code, latitude, longitude)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (name) DO UPDATE SET
+    address = excluded.address,
+    zip_code = ?
+WHERE locations.latitude <> ?
+RETURNING id
+`
+
+type UpsertLocationReturningIDParams struct {
+	Name       string
+	Address    string
+	ZipCode    int64
+	Latitude   float64
+	Longitude  float64
+	ZipCode_2  int64
+	Latitude_2 float64
+}
+
+func (q *Queries) UpsertLocationReturningID(ctx context.Context, arg UpsertLocationReturningIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, upsertLocationReturningID,
+		arg.Name,
+		arg.Address,
+		arg.ZipCode,
+		arg.Latitude,
+		arg.Longitude,
+		arg.ZipCode_2,
+		arg.Latitude_2,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
@@ -13,3 +13,18 @@ ON CONFLICT(name) DO UPDATE SET
     zip_code = excluded.zip_code,
     latitude = excluded.latitude,
     longitude = excluded.longitude;
+
+/* name: UpsertLocationReturningID :one */
+INSERT INTO locations (name, address, zip_code, latitude, longitude)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT (name) DO UPDATE SET
+    address = excluded.address,
+    zip_code = ?
+WHERE locations.latitude <> ?
+RETURNING id;
+
+/* name: InsertLocationIfMissing :many */
+INSERT INTO locations (name, address, zip_code, latitude, longitude)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT DO NOTHING
+RETURNING *;
@@ -0,0 +1,5 @@
+-- name: ListAuthors :many
+SELECT * FROM authors;
+
+-- name: DeleteAuthors :exec
+DELETE FROM authors;
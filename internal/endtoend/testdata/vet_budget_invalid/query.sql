@@ -0,0 +1,14 @@
+-- name: GetAuthor :one
+-- @sqlc-budget cost<abc
+SELECT id, name FROM authors
+WHERE id = $1;
+
+-- name: ListAuthors :many
+-- @sqlc-budget
+SELECT id, name FROM authors
+ORDER BY name;
+
+-- name: CountAuthors :one
+-- @sqlc-budget size<10
+-- @sqlc-vet-disable sqlc/budget
+SELECT count(*) FROM authors;
@@ -0,0 +1,51 @@
+-- name: DeleteAllAuthors :exec
+DELETE FROM authors;
+
+-- name: ClearBios :exec
+UPDATE authors SET bio = NULL;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = ?;
+
+-- name: ListAuthors :many
+SELECT * FROM authors;
+
+-- name: ListAuthorBooks :many
+SELECT a.*, b.title FROM authors a JOIN books b ON b.author_id = a.id;
+
+-- name: CreateAuthor :one
+INSERT INTO authors (name) VALUES (?) RETURNING *;
+
+-- name: AnyAuthor :one
+SELECT id, name FROM authors LIMIT 1;
+
+-- name: FirstAuthor :one
+SELECT id, name FROM authors ORDER BY id LIMIT 1;
+
+-- name: ListUneditedAuthors :many
+SELECT id FROM authors WHERE id NOT IN (SELECT editor_id FROM books);
+
+-- name: ListIdleAuthors :many
+SELECT id FROM authors WHERE id NOT IN (SELECT author_id FROM books);
+
+-- name: ListAuthorBookPairs :many
+SELECT a.id, b.id FROM authors a, books b;
+
+-- name: ListWrittenBooks :many
+SELECT a.id, b.id FROM authors a, books b WHERE b.author_id = a.id;
+
+-- name: ListCrossedBooks :many
+SELECT a.id, b.id FROM authors a CROSS JOIN books b;
+
+-- name: ListBookTitles :many
+SELECT authors.id, title FROM authors, books WHERE author_id = authors.id;
+
+-- name: PageAuthors :many
+SELECT id, name FROM authors ORDER BY id LIMIT ? OFFSET ?;
+
+-- name: SkipFirstAuthor :many
+SELECT id, name FROM authors ORDER BY id LIMIT 10 OFFSET 1;
+
+-- name: ListAllAuthors :many
+-- @sqlc-vet-disable sqlc/select-star
+SELECT * FROM authors ORDER BY id;
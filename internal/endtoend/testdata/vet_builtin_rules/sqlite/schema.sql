@@ -0,0 +1,12 @@
+CREATE TABLE authors (
+  id   INTEGER PRIMARY KEY,
+  name text    NOT NULL,
+  bio  text
+);
+
+CREATE TABLE books (
+  id        INTEGER PRIMARY KEY,
+  author_id INTEGER NOT NULL,
+  editor_id INTEGER,
+  title     text    NOT NULL
+);
@@ -0,0 +1,21 @@
+-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: GetAuthorEmail :one
+SELECT email FROM authors
+WHERE id = $1;
+
+-- name: GetAuthorContact :one
+-- @pii
+SELECT name, email FROM authors
+WHERE id = $1;
+
+-- name: ListAuthorsByBio :many
+SELECT id, name FROM authors
+WHERE bio = sqlc.narg(bio);
+
+-- name: ListAuthorsEverything :many
+SELECT a.id, a.name, a.email, a.bio, b.id, b.title
+FROM authors a
+JOIN book b ON b.author_id = a.id;
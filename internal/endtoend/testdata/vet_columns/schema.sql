@@ -0,0 +1,14 @@
+CREATE TABLE authors (
+  id    BIGSERIAL PRIMARY KEY,
+  name  text      NOT NULL,
+  email text      NOT NULL,
+  bio   text
+);
+
+COMMENT ON COLUMN authors.email IS 'pii';
+
+CREATE TABLE book (
+  id        BIGSERIAL PRIMARY KEY,
+  author_id bigint NOT NULL REFERENCES authors (id),
+  title     text   NOT NULL
+);
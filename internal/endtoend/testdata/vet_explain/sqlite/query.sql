@@ -0,0 +1,14 @@
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = ?;
+
+-- name: GetAuthorByEmail :one
+SELECT * FROM authors WHERE email = ?;
+
+-- name: ListAuthorsByName :many
+SELECT * FROM authors WHERE name = ?;
+
+-- name: ListAuthorsByBio :many
+SELECT * FROM authors ORDER BY bio;
+
+-- name: ListAuthorEmails :many
+SELECT email FROM authors ORDER BY email;
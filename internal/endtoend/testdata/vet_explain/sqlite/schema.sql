@@ -0,0 +1,8 @@
+CREATE TABLE authors (
+  id    INTEGER PRIMARY KEY,
+  name  TEXT NOT NULL,
+  email TEXT NOT NULL,
+  bio   TEXT
+);
+
+CREATE UNIQUE INDEX authors_email ON authors (email);
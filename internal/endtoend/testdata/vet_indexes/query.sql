@@ -0,0 +1,16 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthorsByName :many
+SELECT * FROM authors
+WHERE name = $1;
+
+-- name: ListBooksByAuthor :many
+SELECT b.* FROM books b
+WHERE b.author_id = $1;
+
+-- name: ListBooksByTitle :many
+SELECT b.* FROM books b
+JOIN authors a ON a.id = b.author_id
+WHERE b.title = $1;
@@ -0,0 +1,16 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: ListBooks :many
+SELECT * FROM book
+ORDER BY title
+LIMIT $1;
+
+-- name: ListStaff :many
+-- @sqlc-vet-disable require-limit
+SELECT * FROM staff;
@@ -0,0 +1,15 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL
+);
+
+CREATE TABLE book (
+  id        BIGSERIAL PRIMARY KEY,
+  author_id BIGINT NOT NULL REFERENCES authors (id),
+  title     TEXT NOT NULL
+);
+
+CREATE TABLE staff (
+  id   BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL
+);
@@ -88,6 +88,34 @@ func (q *Queries) SelectAllColsTblFt(ctx context.Context, b string) ([]TblFt, er
 	return items, nil
 }
 
+const selectAllTblFt = `-- name: SelectAllTblFt :many
+SELECT b, c FROM tbl_ft
+WHERE tbl_ft MATCH ?
+`
+
+func (q *Queries) SelectAllTblFt(ctx context.Context, tblFt string) ([]TblFt, error) {
+	rows, err := q.db.QueryContext(ctx, selectAllTblFt, tblFt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TblFt
+	for rows.Next() {
+		var i TblFt
+		if err := rows.Scan(&i.B, &i.C); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectBm25Func = `-- name: SelectBm25Func :many
 SELECT b, c, bm25(tbl_ft, 2.0) FROM tbl_ft
 WHERE b MATCH ? ORDER BY bm25(tbl_ft)
@@ -206,6 +234,41 @@ func (q *Queries) SelectOneColTblFt(ctx context.Context, b string) ([]string, er
 	return items, nil
 }
 
+const selectRankedFt = `-- name: SelectRankedFt :many
+SELECT rowid, b, rank FROM ft
+WHERE ft MATCH ?
+ORDER BY rank
+`
+
+type SelectRankedFtRow struct {
+	Rowid int64
+	B     string
+	Rank  float64
+}
+
+func (q *Queries) SelectRankedFt(ctx context.Context, ft string) ([]SelectRankedFtRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectRankedFt, ft)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectRankedFtRow
+	for rows.Next() {
+		var i SelectRankedFtRow
+		if err := rows.Scan(&i.Rowid, &i.B, &i.Rank); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectSnippetFunc = `-- name: SelectSnippetFunc :many
 SELECT snippet(tbl_ft, 0, '<b>', '</b>', 'aa', ?) FROM tbl_ft
 `
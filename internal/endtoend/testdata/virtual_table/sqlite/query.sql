@@ -33,3 +33,12 @@ DELETE FROM tbl_ft WHERE b = ?;
 
 -- name: InsertTblFt :exec
 INSERT INTO tbl_ft(b, c) VALUES(?, ?);
+
+-- name: SelectRankedFt :many
+SELECT rowid, b, rank FROM ft
+WHERE ft MATCH ?
+ORDER BY rank;
+
+-- name: SelectAllTblFt :many
+SELECT * FROM tbl_ft
+WHERE tbl_ft MATCH ?;
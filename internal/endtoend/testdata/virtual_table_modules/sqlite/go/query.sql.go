@@ -0,0 +1,319 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const findPlaces = `-- name: FindPlaces :many
+SELECT id, label FROM places
+WHERE min_x >= ? AND max_x <= ? AND min_y >= ? AND max_y <= ?
+`
+
+type FindPlacesParams struct {
+	MinX float64
+	MaxX float64
+	MinY float64
+	MaxY float64
+}
+
+type FindPlacesRow struct {
+	ID    int64
+	Label interface{}
+}
+
+func (q *Queries) FindPlaces(ctx context.Context, arg FindPlacesParams) ([]FindPlacesRow, error) {
+	rows, err := q.db.QueryContext(ctx, findPlaces,
+		arg.MinX,
+		arg.MaxX,
+		arg.MinY,
+		arg.MaxY,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindPlacesRow
+	for rows.Next() {
+		var i FindPlacesRow
+		if err := rows.Scan(&i.ID, &i.Label); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertTile = `-- name: InsertTile :exec
+INSERT INTO tiles (id, min_x, max_x) VALUES (?, ?, ?)
+`
+
+type InsertTileParams struct {
+	ID   int64
+	MinX int64
+	MaxX int64
+}
+
+func (q *Queries) InsertTile(ctx context.Context, arg InsertTileParams) error {
+	_, err := q.db.ExecContext(ctx, insertTile, arg.ID, arg.MinX, arg.MaxX)
+	return err
+}
+
+const listDocumentMetaKeys = `-- name: ListDocumentMetaKeys :many
+SELECT documents.id, json_each.key, json_each.value
+FROM documents, json_each(documents.meta)
+WHERE documents.id = ?
+`
+
+type ListDocumentMetaKeysRow struct {
+	ID    int64
+	Key   interface{}
+	Value interface{}
+}
+
+func (q *Queries) ListDocumentMetaKeys(ctx context.Context, id int64) ([]ListDocumentMetaKeysRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDocumentMetaKeys, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDocumentMetaKeysRow
+	for rows.Next() {
+		var i ListDocumentMetaKeysRow
+		if err := rows.Scan(&i.ID, &i.Key, &i.Value); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDocuments = `-- name: ListDocuments :many
+SELECT title, body FROM documents_fts
+`
+
+func (q *Queries) ListDocuments(ctx context.Context) ([]DocumentsFt, error) {
+	rows, err := q.db.QueryContext(ctx, listDocuments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DocumentsFt
+	for rows.Next() {
+		var i DocumentsFt
+		if err := rows.Scan(&i.Title, &i.Body); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listJSONPaths = `-- name: ListJSONPaths :many
+SELECT fullkey, type FROM json_tree(?, '$.items')
+`
+
+type ListJSONPathsRow struct {
+	Fullkey sql.NullString
+	Type    sql.NullString
+}
+
+func (q *Queries) ListJSONPaths(ctx context.Context, jsonTree interface{}) ([]ListJSONPathsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listJSONPaths, jsonTree)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListJSONPathsRow
+	for rows.Next() {
+		var i ListJSONPathsRow
+		if err := rows.Scan(&i.Fullkey, &i.Type); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPages = `-- name: ListPages :many
+SELECT name, pageno, pagetype, pgsize FROM pages WHERE aggregate = FALSE
+`
+
+type ListPagesRow struct {
+	Name     string
+	Pageno   int64
+	Pagetype sql.NullString
+	Pgsize   int64
+}
+
+func (q *Queries) ListPages(ctx context.Context) ([]ListPagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPages)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPagesRow
+	for rows.Next() {
+		var i ListPagesRow
+		if err := rows.Scan(
+			&i.Name,
+			&i.Pageno,
+			&i.Pagetype,
+			&i.Pgsize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRegions = `-- name: ListRegions :many
+SELECT rowid, name FROM regions WHERE geopoly_contains_point(_shape, ?, ?)
+`
+
+type ListRegionsParams struct {
+	GeopolyContainsPoint   interface{}
+	GeopolyContainsPoint_2 interface{}
+}
+
+type ListRegionsRow struct {
+	Rowid int64
+	Name  interface{}
+}
+
+func (q *Queries) ListRegions(ctx context.Context, arg ListRegionsParams) ([]ListRegionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRegions, arg.GeopolyContainsPoint, arg.GeopolyContainsPoint_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRegionsRow
+	for rows.Next() {
+		var i ListRegionsRow
+		if err := rows.Scan(&i.Rowid, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTiles = `-- name: ListTiles :many
+SELECT id, min_x, max_x FROM tiles
+`
+
+func (q *Queries) ListTiles(ctx context.Context) ([]Tile, error) {
+	rows, err := q.db.QueryContext(ctx, listTiles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tile
+	for rows.Next() {
+		var i Tile
+		if err := rows.Scan(&i.ID, &i.MinX, &i.MaxX); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const matchInfo = `-- name: MatchInfo :one
+SELECT matchinfo(documents_fts, 'pcx') FROM documents_fts WHERE documents_fts MATCH ?
+`
+
+func (q *Queries) MatchInfo(ctx context.Context, documentsFts string) ([]byte, error) {
+	row := q.db.QueryRowContext(ctx, matchInfo, documentsFts)
+	var matchinfo []byte
+	err := row.Scan(&matchinfo)
+	return matchinfo, err
+}
+
+const searchDocuments = `-- name: SearchDocuments :many
+SELECT docid, title, offsets(documents_fts) AS offsets
+FROM documents_fts
+WHERE documents_fts MATCH ? AND lang = ?
+`
+
+type SearchDocumentsParams struct {
+	DocumentsFts string
+	Lang         int64
+}
+
+type SearchDocumentsRow struct {
+	Docid   int64
+	Title   string
+	Offsets string
+}
+
+func (q *Queries) SearchDocuments(ctx context.Context, arg SearchDocumentsParams) ([]SearchDocumentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchDocuments, arg.DocumentsFts, arg.Lang)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchDocumentsRow
+	for rows.Next() {
+		var i SearchDocumentsRow
+		if err := rows.Scan(&i.Docid, &i.Title, &i.Offsets); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,34 @@
+-- name: SearchDocuments :many
+SELECT docid, title, offsets(documents_fts) AS offsets
+FROM documents_fts
+WHERE documents_fts MATCH ? AND lang = ?;
+
+-- name: ListDocuments :many
+SELECT * FROM documents_fts;
+
+-- name: MatchInfo :one
+SELECT matchinfo(documents_fts, 'pcx') FROM documents_fts WHERE documents_fts MATCH ?;
+
+-- name: FindPlaces :many
+SELECT id, label FROM places
+WHERE min_x >= ? AND max_x <= ? AND min_y >= ? AND max_y <= ?;
+
+-- name: InsertTile :exec
+INSERT INTO tiles (id, min_x, max_x) VALUES (?, ?, ?);
+
+-- name: ListTiles :many
+SELECT * FROM tiles;
+
+-- name: ListRegions :many
+SELECT rowid, name FROM regions WHERE geopoly_contains_point(_shape, ?, ?);
+
+-- name: ListPages :many
+SELECT name, pageno, pagetype, pgsize FROM pages WHERE aggregate = FALSE;
+
+-- name: ListDocumentMetaKeys :many
+SELECT documents.id, json_each.key, json_each.value
+FROM documents, json_each(documents.meta)
+WHERE documents.id = ?;
+
+-- name: ListJSONPaths :many
+SELECT fullkey, type FROM json_tree(?, '$.items');
@@ -0,0 +1,15 @@
+CREATE TABLE documents (
+    id   INTEGER PRIMARY KEY,
+    body TEXT    NOT NULL,
+    meta TEXT    NOT NULL
+);
+
+CREATE VIRTUAL TABLE documents_fts USING fts4(title, body TEXT, tokenize=porter, languageid="lang");
+
+CREATE VIRTUAL TABLE places USING rtree(id, min_x, max_x, min_y, max_y, +label);
+
+CREATE VIRTUAL TABLE tiles USING rtree_i32(id, min_x, max_x);
+
+CREATE VIRTUAL TABLE regions USING geopoly(name);
+
+CREATE VIRTUAL TABLE pages USING dbstat(main);
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/cmd"
+)
+
+// TestVetBaseline writes the baseline of a package, then checks that the
+// findings in it are suppressed while new ones still fail
+func TestVetBaseline(t *testing.T) {
+	ctx := context.Background()
+	testdata := filepath.Join("testdata", "vet_baseline")
+
+	// The package is copied, as its queries are edited below
+	dir := t.TempDir()
+	for _, name := range []string{"sqlc.yaml", "schema.sql", "query.sql"} {
+		blob, err := os.ReadFile(filepath.Join(testdata, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), blob, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	baseline := filepath.Join(dir, "baseline.json")
+
+	vet := func(t *testing.T, update bool) (string, error) {
+		t.Helper()
+		var stderr bytes.Buffer
+		err := cmd.Vet(ctx, dir, "", &cmd.Options{
+			Env:            cmd.Env{NoRemote: true},
+			Stderr:         &stderr,
+			Baseline:       baseline,
+			UpdateBaseline: update,
+		})
+		return strings.TrimSpace(strings.ReplaceAll(stderr.String(), "\r", "")), err
+	}
+
+	t.Run("Write", func(t *testing.T) {
+		stderr, err := vet(t, true)
+		if err != nil {
+			t.Fatalf("sqlc vet --update-baseline failed: %s", stderr)
+		}
+		if want := "wrote 2 findings to " + baseline; stderr != want {
+			t.Errorf("got %q, want %q", stderr, want)
+		}
+		got, err := os.ReadFile(baseline)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := os.ReadFile(filepath.Join(testdata, "baseline.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(string(want), string(got), lineEndings()); diff != "" {
+			t.Errorf("baseline differed (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Suppress", func(t *testing.T) {
+		stderr, err := vet(t, false)
+		if err != nil {
+			t.Fatalf("known findings failed sqlc vet: %s", stderr)
+		}
+		if stderr != "" {
+			t.Errorf("known findings were reported: %s", stderr)
+		}
+	})
+
+	t.Run("NewFinding", func(t *testing.T) {
+		query := filepath.Join(dir, "query.sql")
+		blob, err := os.ReadFile(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blob = append(blob, "\n-- name: ListAuthorNames :many\nSELECT name FROM authors;\n"...)
+		if err := os.WriteFile(query, blob, 0644); err != nil {
+			t.Fatal(err)
+		}
+		stderr, err := vet(t, false)
+		if !errors.Is(err, cmd.ErrFailedChecks) {
+			t.Fatalf("expected failed checks, got %v", err)
+		}
+		if want := "query.sql: ListAuthorNames: no-limit: :many query without LIMIT"; stderr != want {
+			t.Errorf("got %q, want %q", stderr, want)
+		}
+	})
+}
@@ -0,0 +1,222 @@
+package analyzer
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-sql-driver/mysql"
+
+	core "github.com/sqlc-dev/sqlc/internal/analysis"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/dbmanager"
+	"github.com/sqlc-dev/sqlc/internal/opts"
+	"github.com/sqlc-dev/sqlc/internal/quickdb"
+	"github.com/sqlc-dev/sqlc/internal/shfmt"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/named"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+type Analyzer struct {
+	db       config.Database
+	client   dbmanager.Client
+	conn     *sql.DB
+	dbg      opts.Debug
+	replacer *shfmt.Replacer
+	mu       sync.Mutex
+}
+
+func New(client dbmanager.Client, db config.Database) *Analyzer {
+	return &Analyzer{
+		db:       db,
+		dbg:      opts.DebugFromEnv(),
+		client:   client,
+		replacer: shfmt.NewReplacer(nil),
+	}
+}
+
+// The MySQL driver reports unsigned integers as "UNSIGNED INT", while the
+// dolphin catalog uses lower case type names and a separate unsigned flag.
+func parseType(dt string) (string, bool) {
+	dt = strings.ToLower(dt)
+	if trimmed, ok := strings.CutPrefix(dt, "unsigned "); ok {
+		return trimmed, true
+	}
+	return dt, false
+}
+
+func (a *Analyzer) connect(ctx context.Context, migrations []string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.conn != nil {
+		return nil
+	}
+	var uri string
+	if a.db.Managed {
+		if a.client == nil {
+			return fmt.Errorf("client is nil")
+		}
+		edb, err := a.client.CreateDatabase(ctx, &dbmanager.CreateDatabaseRequest{
+			Engine:     "mysql",
+			Migrations: migrations,
+		})
+		if err != nil {
+			return err
+		}
+		dburi, err := quickdb.MySQLReformatURI(edb.Uri)
+		if err != nil {
+			return fmt.Errorf("reformat uri: %w", err)
+		}
+		uri = dburi
+	} else if a.dbg.OnlyManagedDatabases {
+		return fmt.Errorf("database: connections disabled via SQLCDEBUG=databases=managed")
+	} else {
+		uri = a.replacer.Replace(a.db.URI)
+	}
+	conn, err := sql.Open("mysql", uri)
+	if err != nil {
+		return err
+	}
+	if err := conn.PingContext(ctx); err != nil {
+		conn.Close()
+		return err
+	}
+	a.conn = conn
+	return nil
+}
+
+func (a *Analyzer) Analyze(ctx context.Context, n ast.Node, query string, migrations []string, ps *named.ParamSet) (*core.Analysis, error) {
+	extractSqlErr := func(e error) error {
+		var myErr *mysql.MySQLError
+		if errors.As(e, &myErr) {
+			return &sqlerr.Error{
+				Code:     fmt.Sprintf("%d", myErr.Number),
+				Message:  myErr.Message,
+				Location: max(n.Pos(), 0),
+			}
+		}
+		return e
+	}
+
+	if err := a.connect(ctx, migrations); err != nil {
+		return nil, err
+	}
+
+	conn, err := a.conn.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Preparing the statement validates it against the schema and reports
+	// the number of parameters. MySQL does not describe parameter types, so
+	// those are left to the catalog-based inference.
+	var numInput int
+	err = conn.Raw(func(dc any) error {
+		prep, ok := dc.(driver.ConnPrepareContext)
+		if !ok {
+			return fmt.Errorf("mysql driver does not support prepared statements")
+		}
+		stmt, err := prep.PrepareContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		numInput = stmt.NumInput()
+		return stmt.Close()
+	})
+	if err != nil {
+		return nil, extractSqlErr(err)
+	}
+
+	var result core.Analysis
+
+	cols, err := columnTypes(ctx, conn, n, query, numInput)
+	if err != nil {
+		return nil, extractSqlErr(err)
+	}
+	for _, col := range cols {
+		dt, unsigned := parseType(col.DatabaseTypeName())
+		nullable, ok := col.Nullable()
+		length, _ := col.Length()
+		result.Columns = append(result.Columns, &core.Column{
+			Name:         col.Name(),
+			OriginalName: col.Name(),
+			DataType:     dt,
+			NotNull:      ok && !nullable,
+			Unsigned:     unsigned,
+			Length:       int32(length),
+		})
+	}
+
+	for i := range numInput {
+		name := ""
+		if ps != nil {
+			name, _ = ps.NameFor(i + 1)
+		}
+		result.Params = append(result.Params, &core.Parameter{
+			Number: int32(i + 1),
+			Column: &core.Column{
+				Name: name,
+			},
+		})
+	}
+
+	return &result, nil
+}
+
+// columnTypes returns the result columns for statements that produce rows.
+// The MySQL protocol only describes result columns once a statement has been
+// executed, and the driver drops the columns it's sent on prepare, so the
+// query runs wrapped in a LIMIT 0, which MySQL answers without reading any
+// rows. It runs inside a read-only transaction that is always rolled back,
+// with every parameter bound to NULL.
+func columnTypes(ctx context.Context, conn *sql.Conn, n ast.Node, query string, numInput int) ([]*sql.ColumnType, error) {
+	raw, ok := n.(*ast.RawStmt)
+	if !ok {
+		return nil, nil
+	}
+	if _, ok := raw.Stmt.(*ast.SelectStmt); !ok {
+		return nil, nil
+	}
+
+	tx, err := conn.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// The newlines keep a trailing line comment from commenting out the
+	// rest of the wrapper
+	query = strings.TrimSuffix(strings.TrimSpace(query), ";")
+	args := make([]any, numInput)
+	rows, err := tx.QueryContext(ctx, "SELECT * FROM (\n"+query+"\n) AS sqlc_analyze LIMIT 0", args...)
+	var myErr *mysql.MySQLError
+	if errors.As(err, &myErr) && myErr.Number == errDupFieldName {
+		// A derived table can't have two columns with the same name, as
+		// joins often do, while a parenthesized query can
+		rows, err = tx.QueryContext(ctx, "(\n"+query+"\n) LIMIT 0", args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return rows.ColumnTypes()
+}
+
+// ER_DUP_FIELDNAME
+const errDupFieldName = 1060
+
+func (a *Analyzer) Close(_ context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.conn != nil {
+		return a.conn.Close()
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package analyzer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	core "github.com/sqlc-dev/sqlc/internal/analysis"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+	"github.com/sqlc-dev/sqlc/internal/sqltest/local"
+)
+
+func TestParseType(t *testing.T) {
+	for _, tc := range []struct {
+		in       string
+		dt       string
+		unsigned bool
+	}{
+		{"VARCHAR", "varchar", false},
+		{"BIGINT", "bigint", false},
+		{"UNSIGNED INT", "int", true},
+		{"UNSIGNED BIGINT", "bigint", true},
+	} {
+		dt, unsigned := parseType(tc.in)
+		if dt != tc.dt || unsigned != tc.unsigned {
+			t.Errorf("parseType(%q) = %q, %v; want %q, %v", tc.in, dt, unsigned, tc.dt, tc.unsigned)
+		}
+	}
+}
+
+const schema = `
+CREATE TABLE authors (
+  id   BIGINT       NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  age  INT UNSIGNED
+);
+`
+
+func newAnalyzer(t *testing.T) (*Analyzer, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "schema.sql")
+	if err := os.WriteFile(path, []byte(schema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	uri := local.MySQL(t, []string{path})
+	a := New(nil, config.Database{URI: uri})
+	t.Cleanup(func() { a.Close(context.Background()) })
+	return a, uri
+}
+
+func TestAnalyze(t *testing.T) {
+	ctx := context.Background()
+	a, _ := newAnalyzer(t)
+
+	query := "SELECT id, name, age FROM authors WHERE id = ?"
+	result, err := a.Analyze(ctx, &ast.RawStmt{Stmt: &ast.SelectStmt{}}, query, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cols := []*core.Column{
+		{Name: "id", OriginalName: "id", DataType: "bigint", NotNull: true},
+		{Name: "name", OriginalName: "name", DataType: "varchar", NotNull: true},
+		{Name: "age", OriginalName: "age", DataType: "int", Unsigned: true},
+	}
+	if diff := cmp.Diff(cols, result.Columns, cmp.Comparer(func(a, b *core.Column) bool {
+		return a.Name == b.Name && a.DataType == b.DataType && a.NotNull == b.NotNull && a.Unsigned == b.Unsigned
+	})); diff != "" {
+		t.Errorf("columns differ (-want +got):\n%s", diff)
+	}
+	if len(result.Params) != 1 {
+		t.Errorf("expected 1 parameter, got %d", len(result.Params))
+	}
+}
+
+func TestAnalyzeDoesNotExecuteWrites(t *testing.T) {
+	ctx := context.Background()
+	a, uri := newAnalyzer(t)
+
+	query := "INSERT INTO authors (name) VALUES (?)"
+	result, err := a.Analyze(ctx, &ast.RawStmt{Stmt: &ast.InsertStmt{}}, query, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Columns) != 0 {
+		t.Errorf("expected no columns, got %d", len(result.Columns))
+	}
+	if len(result.Params) != 1 {
+		t.Errorf("expected 1 parameter, got %d", len(result.Params))
+	}
+
+	db, err := sql.Open("mysql", uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	var count int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM authors").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("expected no rows to be inserted, got %d", count)
+	}
+}
+
+func TestAnalyzeError(t *testing.T) {
+	ctx := context.Background()
+	a, _ := newAnalyzer(t)
+
+	query := "SELECT missing FROM authors"
+	_, err := a.Analyze(ctx, &ast.RawStmt{Stmt: &ast.SelectStmt{}}, query, nil, nil)
+	var serr *sqlerr.Error
+	if !errors.As(err, &serr) {
+		t.Fatalf("expected a *sqlerr.Error, got %v", err)
+	}
+	if serr.Code != "1054" {
+		t.Errorf("expected code 1054, got %s: %s", serr.Code, serr.Message)
+	}
+}
+
+func TestAnalyzeDoesNotReadRows(t *testing.T) {
+	ctx := context.Background()
+	a, uri := newAnalyzer(t)
+
+	db, err := sql.Open("mysql", uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	// audit records every row it's called for, which would fail inside the
+	// read-only transaction of the analyzer
+	for _, stmt := range []string{
+		"CREATE TABLE audits (author_id BIGINT NOT NULL)",
+		`CREATE FUNCTION audit(author_id BIGINT) RETURNS BIGINT DETERMINISTIC MODIFIES SQL DATA
+		BEGIN
+			INSERT INTO audits (author_id) VALUES (author_id);
+			RETURN author_id;
+		END`,
+		"INSERT INTO authors (name) VALUES ('Ursula'), ('Octavia'), ('Ted')",
+	} {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, query := range []string{
+		"SELECT id, audit(id) AS audited FROM authors -- every author",
+		// Both columns are named id, which a derived table doesn't allow
+		"SELECT a.id, b.id, audit(a.id) FROM authors a JOIN authors b ON b.id = a.id;",
+	} {
+		if _, err := a.Analyze(ctx, &ast.RawStmt{Stmt: &ast.SelectStmt{}}, query, nil, nil); err != nil {
+			t.Fatalf("%s: %s", query, err)
+		}
+	}
+
+	var count int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM audits").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("expected no rows to be read, got %d audits", count)
+	}
+}
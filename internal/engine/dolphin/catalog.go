@@ -11,6 +11,7 @@ func NewCatalog() *catalog.Catalog {
 		Schemas: []*catalog.Schema{
 			defaultSchema(def),
 		},
-		Extensions: map[string]struct{}{},
+		Extensions:         map[string]struct{}{},
+		IndexNamesPerTable: true,
 	}
 }
@@ -0,0 +1,107 @@
+package dolphin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+func build(t *testing.T, sql string) (*catalog.Catalog, error) {
+	t.Helper()
+	stmts, err := NewParser().Parse(strings.NewReader(sql))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	return c, c.Build(stmts)
+}
+
+func TestIndexes(t *testing.T) {
+	c, err := build(t, `
+		CREATE TABLE authors (
+			id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			name VARCHAR(255) UNIQUE,
+			bio TEXT
+		);
+		CREATE TABLE books (
+			id BIGINT NOT NULL,
+			author_id BIGINT,
+			isbn VARCHAR(13),
+			title VARCHAR(255),
+			PRIMARY KEY (id),
+			UNIQUE KEY books_title (author_id, title),
+			INDEX books_isbn (isbn),
+			CONSTRAINT books_author FOREIGN KEY (author_id) REFERENCES authors (id)
+		);
+		CREATE INDEX authors_bio ON authors (bio(10));
+		-- Index names only have to be unique within a table
+		CREATE INDEX books_isbn ON authors (name);
+		DROP INDEX books_isbn ON authors;
+		ALTER TABLE books DROP INDEX books_title;
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]*catalog.Index{
+		"authors": {
+			{Name: "PRIMARY", Columns: []string{"id"}, Unique: true, Primary: true, Constraint: true},
+			{Name: "name", Columns: []string{"name"}, Unique: true, Constraint: true},
+			{Name: "authors_bio", Columns: []string{"bio"}},
+		},
+		"books": {
+			{Name: "PRIMARY", Columns: []string{"id"}, Unique: true, Primary: true, Constraint: true},
+			{Name: "books_isbn", Columns: []string{"isbn"}},
+		},
+	}
+	for name, indexes := range want {
+		table, err := c.GetTable(&ast.TableName{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(indexes, table.Indexes); diff != "" {
+			t.Errorf("%s indexes mismatch:\n%s", name, diff)
+		}
+	}
+
+	books, err := c.GetTable(&ast.TableName{Name: "books"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fks := []*catalog.ForeignKey{
+		{
+			Name:       "books_author",
+			Columns:    []string{"author_id"},
+			RefTable:   &ast.TableName{Name: "authors"},
+			RefColumns: []string{"id"},
+		},
+	}
+	if diff := cmp.Diff(fks, books.ForeignKeys); diff != "" {
+		t.Errorf("foreign keys mismatch:\n%s", diff)
+	}
+}
+
+func TestIndexRenamedAndRecreated(t *testing.T) {
+	// The catalog doesn't track renamed indexes, so the second index with
+	// the same name mustn't be reported as a duplicate
+	c, err := build(t, `
+		CREATE TABLE authors (name TEXT);
+		CREATE INDEX authors_name ON authors (name(10));
+		ALTER TABLE authors RENAME INDEX authors_name TO authors_name_old;
+		CREATE INDEX authors_name ON authors (name(20));
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	table, err := c.GetTable(&ast.TableName{Name: "authors"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table.Indexes) != 2 {
+		t.Errorf("got %d indexes, want 2", len(table.Indexes))
+	}
+}
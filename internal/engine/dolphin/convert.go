@@ -1,6 +1,7 @@
 package dolphin
 
 import (
+	"fmt"
 	"log"
 	"strings"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/sqlc-dev/sqlc/internal/debug"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 )
 
 type cc struct {
@@ -38,6 +40,9 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 		Table: parseTableName(n.Table),
 		Cmds:  &ast.List{},
 	}
+	// Indexes are created and dropped by separate statements that run after
+	// the ALTER TABLE
+	var indexes []ast.Node
 	for _, spec := range n.Specs {
 		switch spec.Tp {
 		case pcast.AlterTableAddColumns:
@@ -92,7 +97,37 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 			// 	spew.Dump("alter column", spec)
 
 		case pcast.AlterTableAddConstraint:
-			// 	spew.Dump("add const", spec)
+			switch node := c.convertTableConstraint(n.Table, spec.Constraint).(type) {
+			case *ast.Constraint:
+				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+					Subtype:    ast.AT_AddConstraint,
+					Constraint: node,
+				})
+			case *ast.IndexStmt:
+				indexes = append(indexes, node)
+			}
+
+		case pcast.AlterTableDropPrimaryKey:
+			name := "PRIMARY"
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:    &name,
+				Subtype: ast.AT_DropConstraint,
+			})
+
+		case pcast.AlterTableDropIndex:
+			indexes = append(indexes, &ast.DropIndexStmt{
+				IfExists: spec.IfExists,
+				Indexes:  []*ast.TableName{{Name: identifier(spec.Name)}},
+				Table:    parseTableName(n.Table),
+			})
+
+		case pcast.AlterTableDropForeignKey:
+			name := identifier(spec.Name)
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:      &name,
+				Subtype:   ast.AT_DropConstraint,
+				MissingOk: spec.IfExists,
+			})
 
 		case pcast.AlterTableRenameColumn:
 			// TODO: Returning here may be incorrect if there are multiple specs
@@ -118,6 +153,9 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 			continue
 		}
 	}
+	if len(indexes) > 0 {
+		return &ast.List{Items: append([]ast.Node{alt}, indexes...)}
+	}
 	return alt
 }
 
@@ -220,6 +258,40 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 	}
 	for _, def := range n.Cols {
 		create.Cols = append(create.Cols, convertColumnDef(def))
+		col := identifier(def.Name.String())
+		for _, opt := range def.Options {
+			switch opt.Tp {
+			case pcast.ColumnOptionPrimaryKey:
+				create.Constraints = append(create.Constraints, &ast.Constraint{
+					Contype: ast.ConstrPrimary,
+					Conname: indexName("PRIMARY", nil),
+					Keys:    astutils.StringList([]string{col}),
+				})
+			case pcast.ColumnOptionUniqKey:
+				create.Constraints = append(create.Constraints, &ast.Constraint{
+					Contype: ast.ConstrUnique,
+					Conname: indexName("", []string{col}),
+					Keys:    astutils.StringList([]string{col}),
+				})
+			}
+		}
+	}
+	// Plain indexes aren't constraints, so they're returned after the table
+	// as separate CREATE INDEX statements
+	var indexes []ast.Node
+	fks := 0
+	for _, con := range n.Constraints {
+		switch node := c.convertTableConstraint(n.Table, con).(type) {
+		case *ast.Constraint:
+			if node.Contype == ast.ConstrForeign && node.Conname == nil {
+				fks++
+				name := fmt.Sprintf("%s_ibfk_%d", create.Name.Name, fks)
+				node.Conname = &name
+			}
+			create.Constraints = append(create.Constraints, node)
+		case *ast.IndexStmt:
+			indexes = append(indexes, node)
+		}
 	}
 	for _, opt := range n.Options {
 		switch opt.Tp {
@@ -227,9 +299,56 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 			create.Comment = opt.StrValue
 		}
 	}
+	if len(indexes) > 0 {
+		return &ast.List{Items: append([]ast.Node{create}, indexes...)}
+	}
 	return create
 }
 
+// convertTableConstraint converts a constraint in a table definition. PRIMARY
+// KEY, UNIQUE and FOREIGN KEY constraints become an *ast.Constraint, plain
+// and FULLTEXT indexes an *ast.IndexStmt. Other constraints return nil.
+func (c *cc) convertTableConstraint(table *pcast.TableName, n *pcast.Constraint) ast.Node {
+	cols := indexColumns(n.Keys)
+	switch n.Tp {
+	case pcast.ConstraintPrimaryKey:
+		return &ast.Constraint{
+			Contype: ast.ConstrPrimary,
+			Conname: indexName("PRIMARY", nil),
+			Keys:    astutils.StringList(cols),
+		}
+	case pcast.ConstraintUniq, pcast.ConstraintUniqKey, pcast.ConstraintUniqIndex:
+		return &ast.Constraint{
+			Contype: ast.ConstrUnique,
+			Conname: indexName(n.Name, cols),
+			Keys:    astutils.StringList(cols),
+		}
+	case pcast.ConstraintForeignKey:
+		if n.Refer == nil || n.Refer.Table == nil {
+			return nil
+		}
+		con := &ast.Constraint{
+			Contype: ast.ConstrForeign,
+			Pktable: c.convertTableName(n.Refer.Table),
+			FkAttrs: astutils.StringList(cols),
+			PkAttrs: astutils.StringList(indexColumns(n.Refer.IndexPartSpecifications)),
+		}
+		if n.Name != "" {
+			con.Conname = &n.Name
+		}
+		return con
+	case pcast.ConstraintKey, pcast.ConstraintIndex, pcast.ConstraintFulltext:
+		return &ast.IndexStmt{
+			Idxname:     indexName(n.Name, cols),
+			Relation:    c.convertTableName(table),
+			IndexParams: indexParams(cols),
+			IfNotExists: n.IfNotExists,
+		}
+	default:
+		return nil
+	}
+}
+
 func convertColumnDef(def *pcast.ColumnDef) *ast.ColumnDef {
 	var vals *ast.List
 	if len(def.Tp.GetElems()) > 0 {
@@ -241,12 +360,15 @@ func convertColumnDef(def *pcast.ColumnDef) *ast.ColumnDef {
 		}
 	}
 	comment := ""
+	dflt := ""
 	for _, opt := range def.Options {
 		switch opt.Tp {
 		case pcast.ColumnOptionComment:
 			if value, ok := opt.Expr.(*driver.ValueExpr); ok {
 				comment = value.GetString()
 			}
+		case pcast.ColumnOptionDefaultValue:
+			dflt = restore(opt.Expr)
 		}
 	}
 	columnDef := ast.ColumnDef{
@@ -256,6 +378,7 @@ func convertColumnDef(def *pcast.ColumnDef) *ast.ColumnDef {
 		IsUnsigned: isUnsigned(def),
 		Comment:    comment,
 		Vals:       vals,
+		Default:    dflt,
 	}
 	if def.Tp.GetFlen() >= 0 {
 		length := def.Tp.GetFlen()
@@ -771,7 +894,14 @@ func (c *cc) convertCreateDatabaseStmt(n *pcast.CreateDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertCreateIndexStmt(n *pcast.CreateIndexStmt) ast.Node {
-	return todo(n)
+	cols := indexColumns(n.IndexPartSpecifications)
+	return &ast.IndexStmt{
+		Idxname:     indexName(identifier(n.IndexName), cols),
+		Relation:    c.convertTableName(n.Table),
+		IndexParams: indexParams(cols),
+		Unique:      n.KeyType == pcast.IndexKeyTypeUnique,
+		IfNotExists: n.IfNotExists,
+	}
 }
 
 func (c *cc) convertCreateSequenceStmt(n *pcast.CreateSequenceStmt) ast.Node {
@@ -827,7 +957,11 @@ func (c *cc) convertDropDatabaseStmt(n *pcast.DropDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertDropIndexStmt(n *pcast.DropIndexStmt) ast.Node {
-	return todo(n)
+	return &ast.DropIndexStmt{
+		IfExists: n.IfExists,
+		Indexes:  []*ast.TableName{{Name: identifier(n.IndexName)}},
+		Table:    parseTableName(n.Table),
+	}
 }
 
 func (c *cc) convertDropSequenceStmt(n *pcast.DropSequenceStmt) ast.Node {
@@ -1117,7 +1251,13 @@ func (c *cc) convertRollbackStmt(n *pcast.RollbackStmt) ast.Node {
 }
 
 func (c *cc) convertRowExpr(n *pcast.RowExpr) ast.Node {
-	return todo(n)
+	args := &ast.List{}
+	for _, v := range n.Values {
+		args.Items = append(args.Items, c.convert(v))
+	}
+	return &ast.RowExpr{
+		Args: args,
+	}
 }
 
 func (c *cc) convertSetCollationExpr(n *pcast.SetCollationExpr) ast.Node {
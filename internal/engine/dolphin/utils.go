@@ -1,7 +1,10 @@
 package dolphin
 
 import (
+	"strings"
+
 	pcast "github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/format"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
@@ -80,6 +83,52 @@ func convertToRangeVarList(list *ast.List, result *ast.List) {
 	}
 }
 
+// restore returns the SQL text of an expression, or an empty string if it
+// can't be formatted.
+func restore(n pcast.Node) string {
+	var sb strings.Builder
+	flags := format.DefaultRestoreFlags | format.RestoreStringWithoutCharset
+	if err := n.Restore(format.NewRestoreCtx(flags, &sb)); err != nil {
+		return ""
+	}
+	return sb.String()
+}
+
 func isUnsigned(n *pcast.ColumnDef) bool {
 	return mysql.HasUnsignedFlag(n.Tp.GetFlag())
 }
+
+func indexColumns(keys []*pcast.IndexPartSpecification) []string {
+	cols := make([]string, len(keys))
+	for i, key := range keys {
+		// Expression indexes don't have a column name
+		if key.Column != nil {
+			cols[i] = identifier(key.Column.Name.String())
+		}
+	}
+	return cols
+}
+
+func indexParams(cols []string) *ast.List {
+	list := &ast.List{}
+	for i := range cols {
+		elem := &ast.IndexElem{}
+		if cols[i] != "" {
+			elem.Name = &cols[i]
+		}
+		list.Items = append(list.Items, elem)
+	}
+	return list
+}
+
+// indexName returns the name MySQL gives an index created without one,
+// which is the name of its first column
+func indexName(name string, cols []string) *string {
+	if name == "" && len(cols) > 0 {
+		name = cols[0]
+	}
+	if name == "" {
+		return nil
+	}
+	return &name
+}
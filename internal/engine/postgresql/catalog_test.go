@@ -6,6 +6,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
 
 	"github.com/google/go-cmp/cmp"
@@ -168,3 +170,100 @@ func TestUpdateErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexes(t *testing.T) {
+	p := NewParser()
+	stmts, err := p.Parse(strings.NewReader(`
+		CREATE SCHEMA other;
+		CREATE TABLE authors (id bigserial PRIMARY KEY, name text UNIQUE, bio text);
+		CREATE TABLE books (
+			id bigserial,
+			author_id bigint REFERENCES authors,
+			isbn text,
+			title text,
+			PRIMARY KEY (id),
+			CONSTRAINT books_title UNIQUE (author_id, title)
+		);
+		CREATE TABLE other.books (isbn text);
+		CREATE INDEX books_isbn ON books (isbn);
+		CREATE INDEX books_isbn ON other.books (isbn);
+		CREATE INDEX books_lower ON books (lower(title));
+		ALTER TABLE authors ADD CONSTRAINT authors_bio UNIQUE (bio);
+		ALTER TABLE books DROP CONSTRAINT books_title;
+		DROP INDEX books_lower;
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]*catalog.Index{
+		"public.authors": {
+			{Name: "authors_pkey", Columns: []string{"id"}, Unique: true, Primary: true, Constraint: true},
+			{Name: "authors_name_key", Columns: []string{"name"}, Unique: true, Constraint: true},
+			{Name: "authors_bio", Columns: []string{"bio"}, Unique: true, Constraint: true},
+		},
+		"public.books": {
+			{Name: "books_pkey", Columns: []string{"id"}, Unique: true, Primary: true, Constraint: true},
+			{Name: "books_isbn", Columns: []string{"isbn"}},
+		},
+		"other.books": {
+			{Name: "books_isbn", Columns: []string{"isbn"}},
+		},
+	}
+	for key, indexes := range want {
+		schema, name, _ := strings.Cut(key, ".")
+		table, err := c.GetTable(&ast.TableName{Schema: schema, Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(indexes, table.Indexes); diff != "" {
+			t.Errorf("%s indexes mismatch:\n%s", key, diff)
+		}
+	}
+
+	books, err := c.GetTable(&ast.TableName{Name: "books"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fks := []*catalog.ForeignKey{
+		{
+			Name:       "books_author_id_fkey",
+			Columns:    []string{"author_id"},
+			RefTable:   &ast.TableName{Name: "authors"},
+			RefColumns: []string{"id"},
+		},
+	}
+	if diff := cmp.Diff(fks, books.ForeignKeys); diff != "" {
+		t.Errorf("foreign keys mismatch:\n%s", diff)
+	}
+}
+
+func TestIndexRenamedAndRecreated(t *testing.T) {
+	// The catalog doesn't track renamed indexes, so the second index with
+	// the same name mustn't be reported as a duplicate
+	stmts, err := NewParser().Parse(strings.NewReader(`
+		CREATE TABLE authors (name text);
+		CREATE INDEX authors_name_idx ON authors (name);
+		ALTER INDEX authors_name_idx RENAME TO authors_name_old_idx;
+		CREATE INDEX authors_name_idx ON authors (lower(name));
+		CREATE INDEX IF NOT EXISTS authors_name_idx ON authors (name);
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	table, err := c.GetTable(&ast.TableName{Name: "authors"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table.Indexes) != 2 {
+		t.Errorf("got %d indexes, want 2", len(table.Indexes))
+	}
+}
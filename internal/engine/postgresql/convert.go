@@ -888,9 +888,15 @@ func convertCompositeTypeStmt(n *pg.CompositeTypeStmt) *ast.CompositeTypeStmt {
 		return nil
 	}
 	rel := parseRelationFromRangeVar(n.Typevar)
-	return &ast.CompositeTypeStmt{
+	stmt := &ast.CompositeTypeStmt{
 		TypeName: rel.TypeName(),
 	}
+	for _, node := range n.Coldeflist {
+		if def, ok := node.Node.(*pg.Node_ColumnDef); ok {
+			stmt.Cols = append(stmt.Cols, convertColumnDef(def.ColumnDef))
+		}
+	}
+	return stmt
 }
 
 func convertConstraint(n *pg.Constraint) *ast.Constraint {
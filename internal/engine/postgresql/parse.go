@@ -241,7 +241,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				MissingOk: n.MissingOk,
 			}, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			rel, err := parseRelation(n.Object)
 			if err != nil {
 				return nil, err
@@ -285,6 +285,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 						IsNotNull: isNotNull(d.ColumnDef),
 						IsArray:   isArray(d.ColumnDef.TypeName),
 						ArrayDims: len(d.ColumnDef.TypeName.ArrayBounds),
+						Default:   defaultValue(d.ColumnDef),
 					}
 
 				case nodes.AlterTableType_AT_AlterColumnType:
@@ -322,6 +323,20 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				case nodes.AlterTableType_AT_SetNotNull:
 					item.Subtype = ast.AT_SetNotNull
 
+				case nodes.AlterTableType_AT_AddConstraint:
+					d, ok := altercmd.Def.Node.(*nodes.Node_Constraint)
+					if !ok {
+						return nil, fmt.Errorf("expected alter table definition to be a Constraint")
+					}
+					item.Constraint = translateConstraint(rel.Name, "", d.Constraint)
+					if item.Constraint == nil {
+						continue
+					}
+					item.Subtype = ast.AT_AddConstraint
+
+				case nodes.AlterTableType_AT_DropConstraint:
+					item.Subtype = ast.AT_DropConstraint
+
 				default:
 					continue
 				}
@@ -366,7 +381,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				Comment: makeString(n.Comment),
 			}, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			rel, err := parseRelation(n.Object)
 			if err != nil {
 				return nil, err
@@ -392,8 +407,40 @@ func translate(node *nodes.Node) (ast.Node, error) {
 	case *nodes.Node_CompositeTypeStmt:
 		n := inner.CompositeTypeStmt
 		rel := parseRelationFromRangeVar(n.Typevar)
-		return &ast.CompositeTypeStmt{
+		stmt := &ast.CompositeTypeStmt{
 			TypeName: rel.TypeName(),
+		}
+		for _, elt := range n.Coldeflist {
+			item, ok := elt.Node.(*nodes.Node_ColumnDef)
+			if !ok {
+				continue
+			}
+			rel, err := parseRelationFromNodes(item.ColumnDef.TypeName.Names)
+			if err != nil {
+				return nil, err
+			}
+			stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
+				Colname:   item.ColumnDef.Colname,
+				TypeName:  rel.TypeName(),
+				IsArray:   isArray(item.ColumnDef.TypeName),
+				ArrayDims: len(item.ColumnDef.TypeName.ArrayBounds),
+			})
+		}
+		return stmt, nil
+
+	case *nodes.Node_CreateDomainStmt:
+		n := inner.CreateDomainStmt
+		rel, err := parseRelationFromNodes(n.TypeName.Names)
+		if err != nil {
+			return nil, err
+		}
+		typ := rel.TypeName()
+		typ.ArrayBounds = convertSlice(n.TypeName.ArrayBounds)
+		return &ast.CreateDomainStmt{
+			Domainname:  convertSlice(n.Domainname),
+			TypeName:    typ,
+			CollClause:  convertCollateClause(n.CollClause),
+			Constraints: convertSlice(n.Constraints),
 		}, nil
 
 	case *nodes.Node_CreateStmt:
@@ -423,6 +470,10 @@ func translate(node *nodes.Node) (ast.Node, error) {
 					}
 				}
 
+				if con := translateConstraint(rel.Name, "", item.Constraint); con != nil {
+					create.Constraints = append(create.Constraints, con)
+				}
+
 			case *nodes.Node_TableLikeClause:
 				rel := parseRelationFromRangeVar(item.TableLikeClause.Relation)
 				create.ReferTable = rel.TableName()
@@ -440,6 +491,9 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				for _, con := range item.ColumnDef.Constraints {
 					if constraint, ok := con.Node.(*nodes.Node_Constraint); ok {
 						primary = constraint.Constraint.Contype == nodes.ConstrType_CONSTR_PRIMARY
+						if c := translateConstraint(create.Name.Name, item.ColumnDef.Colname, constraint.Constraint); c != nil {
+							create.Constraints = append(create.Constraints, c)
+						}
 					}
 				}
 
@@ -450,11 +504,29 @@ func translate(node *nodes.Node) (ast.Node, error) {
 					IsArray:    isArray(item.ColumnDef.TypeName),
 					ArrayDims:  len(item.ColumnDef.TypeName.ArrayBounds),
 					PrimaryKey: primary,
+					Default:    defaultValue(item.ColumnDef),
 				})
 			}
 		}
 		return create, nil
 
+	case *nodes.Node_IndexStmt:
+		idx := convertIndexStmt(inner.IndexStmt)
+		if idx.Idxname == nil && idx.Relation != nil && idx.Relation.Relname != nil {
+			// Unnamed indexes are named after their table and columns
+			var cols []string
+			for _, elt := range inner.IndexStmt.IndexParams {
+				if elem, ok := elt.Node.(*nodes.Node_IndexElem); ok && elem.IndexElem.Name != "" {
+					cols = append(cols, elem.IndexElem.Name)
+				} else {
+					cols = append(cols, "expr")
+				}
+			}
+			name := *idx.Relation.Relname + "_" + strings.Join(cols, "_") + "_idx"
+			idx.Idxname = &name
+		}
+		return idx, nil
+
 	case *nodes.Node_CreateEnumStmt:
 		n := inner.CreateEnumStmt
 		rel, err := parseRelationFromNodes(n.TypeName)
@@ -562,6 +634,19 @@ func translate(node *nodes.Node) (ast.Node, error) {
 			}
 			return drop, nil
 
+		case nodes.ObjectType_OBJECT_INDEX:
+			drop := &ast.DropIndexStmt{
+				IfExists: n.MissingOk,
+			}
+			for _, obj := range n.Objects {
+				name, err := parseRelation(obj)
+				if err != nil {
+					return nil, fmt.Errorf("nodes.DropStmt: INDEX: %w", err)
+				}
+				drop.Indexes = append(drop.Indexes, name.TableName())
+			}
+			return drop, nil
+
 		case nodes.ObjectType_OBJECT_SCHEMA:
 			drop := &ast.DropSchemaStmt{
 				MissingOk: n.MissingOk,
@@ -588,7 +673,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 			}
 			return drop, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			drop := &ast.DropTypeStmt{
 				IfExists: n.MissingOk,
 			}
@@ -625,7 +710,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				MissingOk: n.MissingOk,
 			}, nil
 
-		case nodes.ObjectType_OBJECT_TYPE:
+		case nodes.ObjectType_OBJECT_TYPE, nodes.ObjectType_OBJECT_DOMAIN:
 			rel, err := parseRelation(n.Object)
 			if err != nil {
 				return nil, fmt.Errorf("nodes.RenameStmt: TYPE: %w", err)
@@ -1,7 +1,12 @@
 package postgresql
 
 import (
+	"strings"
+
 	nodes "github.com/pganalyze/pg_query_go/v5"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 )
 
 func isArray(n *nodes.TypeName) bool {
@@ -29,6 +34,17 @@ func isNotNull(n *nodes.ColumnDef) bool {
 	return false
 }
 
+// defaultValue returns the expression of a column's DEFAULT constraint, or an
+// empty string if it has none.
+func defaultValue(n *nodes.ColumnDef) string {
+	for _, c := range n.Constraints {
+		if inner, ok := c.Node.(*nodes.Node_Constraint); ok && inner.Constraint.Contype == nodes.ConstrType_CONSTR_DEFAULT {
+			return ast.Format(convertNode(inner.Constraint.RawExpr))
+		}
+	}
+	return ""
+}
+
 func IsNamedParamFunc(node *nodes.Node) bool {
 	fun, ok := node.Node.(*nodes.Node_FuncCall)
 	return ok && joinNodes(fun.FuncCall.Funcname, ".") == "sqlc.arg"
@@ -61,3 +77,45 @@ func makeString(s string) *string {
 	}
 	return &s
 }
+
+// translateConstraint converts the primary key, unique and foreign key
+// constraints tracked by the catalog, and returns nil for the others. Column
+// constraints don't list their column, so it's passed in as col. Unnamed
+// constraints get the name PostgreSQL would choose.
+func translateConstraint(table, col string, n *nodes.Constraint) *ast.Constraint {
+	var keys []string
+	var suffix string
+	switch n.Contype {
+	case nodes.ConstrType_CONSTR_PRIMARY:
+		keys, suffix = stringSliceFromNodes(n.Keys), "pkey"
+	case nodes.ConstrType_CONSTR_UNIQUE:
+		keys, suffix = stringSliceFromNodes(n.Keys), "key"
+	case nodes.ConstrType_CONSTR_FOREIGN:
+		keys, suffix = stringSliceFromNodes(n.FkAttrs), "fkey"
+	default:
+		return nil
+	}
+	if len(keys) == 0 && col != "" {
+		keys = []string{col}
+	}
+	con := &ast.Constraint{
+		Contype:  ast.ConstrType(n.Contype),
+		Conname:  makeString(n.Conname),
+		Location: int(n.Location),
+	}
+	if con.Conname == nil {
+		name := table + "_" + suffix
+		if n.Contype != nodes.ConstrType_CONSTR_PRIMARY {
+			name = table + "_" + strings.Join(keys, "_") + "_" + suffix
+		}
+		con.Conname = &name
+	}
+	if n.Contype == nodes.ConstrType_CONSTR_FOREIGN {
+		con.FkAttrs = astutils.StringList(keys)
+		con.Pktable = convertRangeVar(n.Pktable)
+		con.PkAttrs = astutils.StringList(stringSliceFromNodes(n.PkAttrs))
+	} else {
+		con.Keys = astutils.StringList(keys)
+	}
+	return con
+}
@@ -0,0 +1,178 @@
+//go:build !wasm
+
+package analyzer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	core "github.com/sqlc-dev/sqlc/internal/analysis"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/engine/sqlite"
+	"github.com/sqlc-dev/sqlc/internal/opts"
+	"github.com/sqlc-dev/sqlc/internal/shfmt"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/named"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+type Analyzer struct {
+	db       config.Database
+	conn     *conn
+	dbg      opts.Debug
+	replacer *shfmt.Replacer
+	mu       sync.Mutex
+}
+
+func New(db config.Database) *Analyzer {
+	return &Analyzer{
+		db:       db,
+		dbg:      opts.DebugFromEnv(),
+		replacer: shfmt.NewReplacer(nil),
+	}
+}
+
+// connect opens the database used for analysis. Managed databases are private
+// in-memory databases with the schema applied; SQLite doesn't need a server.
+func (a *Analyzer) connect(migrations []string) error {
+	if a.conn != nil {
+		return nil
+	}
+	if a.db.Managed {
+		conn, err := open(":memory:", sqlite3.SQLITE_OPEN_READWRITE|sqlite3.SQLITE_OPEN_CREATE)
+		if err != nil {
+			return err
+		}
+		for _, m := range migrations {
+			if len(strings.TrimSpace(m)) == 0 {
+				continue
+			}
+			if err := conn.exec(m); err != nil {
+				conn.close()
+				return fmt.Errorf("apply schema: %w", err)
+			}
+		}
+		a.conn = conn
+		return nil
+	}
+	if a.dbg.OnlyManagedDatabases {
+		return fmt.Errorf("database: connections disabled via SQLCDEBUG=databases=managed")
+	}
+	// The database of the user is only read, and must already exist
+	conn, err := open(a.replacer.Replace(a.db.URI), sqlite3.SQLITE_OPEN_READONLY)
+	if err != nil {
+		return err
+	}
+	a.conn = conn
+	return nil
+}
+
+func (a *Analyzer) Analyze(ctx context.Context, n ast.Node, query string, migrations []string, ps *named.ParamSet) (*core.Analysis, error) {
+	extractSqlErr := func(e error) error {
+		var liteErr *sqliteError
+		if errors.As(e, &liteErr) {
+			return &sqlerr.Error{
+				Code:     fmt.Sprintf("%d", liteErr.code),
+				Message:  liteErr.msg,
+				Location: max(n.Pos()+int(liteErr.offset), 0),
+			}
+		}
+		return e
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.connect(migrations); err != nil {
+		return nil, err
+	}
+
+	stmt, err := a.conn.describe(query)
+	if err != nil {
+		return nil, extractSqlErr(err)
+	}
+
+	var result core.Analysis
+	nullable := outerJoined(n)
+	for _, col := range stmt.Columns {
+		// Expressions have no declared type; the data type is left empty so
+		// that the compiler keeps the type inferred from the catalog.
+		column := &core.Column{
+			Name:         col.Name,
+			OriginalName: col.Name,
+			NotNull:      col.NotNull && !nullable[strings.ToLower(col.Table)],
+		}
+		if col.DeclType != "" {
+			column.DataType = sqlite.DataType(col.DeclType)
+		}
+		if col.Table != "" {
+			column.Table = &core.Identifier{
+				Schema: col.Database,
+				Name:   col.Table,
+			}
+		}
+		result.Columns = append(result.Columns, column)
+	}
+
+	for i := range stmt.NumParams {
+		name := ""
+		if ps != nil {
+			name, _ = ps.NameFor(i + 1)
+		}
+		result.Params = append(result.Params, &core.Parameter{
+			Number: int32(i + 1),
+			Column: &core.Column{
+				Name: name,
+			},
+		})
+	}
+
+	return &result, nil
+}
+
+// outerJoined returns the tables on the nullable side of an outer join, whose
+// columns are NULL when no row matched. SQLite reports the table a column
+// comes from, not its alias, so a table counts as outer joined everywhere in
+// the statement once it's outer joined anywhere.
+func outerJoined(n ast.Node) map[string]bool {
+	tables := map[string]bool{}
+	addAll := func(node ast.Node) {
+		astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+			if rv, ok := node.(*ast.RangeVar); ok && rv.Relname != nil {
+				tables[strings.ToLower(*rv.Relname)] = true
+			}
+		}), node)
+	}
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		join, ok := node.(*ast.JoinExpr)
+		if !ok {
+			return
+		}
+		switch join.Jointype {
+		case ast.JoinTypeLeft:
+			addAll(join.Rarg)
+		case ast.JoinTypeRight:
+			addAll(join.Larg)
+		case ast.JoinTypeFull:
+			addAll(join.Larg)
+			addAll(join.Rarg)
+		}
+	}), n)
+	return tables
+}
+
+func (a *Analyzer) Close(_ context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.conn != nil {
+		err := a.conn.close()
+		a.conn = nil
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+//go:build !wasm
+
+package analyzer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	core "github.com/sqlc-dev/sqlc/internal/analysis"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/engine/sqlite"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const schema = `
+CREATE TABLE authors (
+  id   INTEGER PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  bio  TEXT,
+  born DATETIME
+);
+
+CREATE TABLE books (
+  id        INTEGER PRIMARY KEY,
+  author_id INTEGER NOT NULL REFERENCES authors (id),
+  title     TEXT NOT NULL
+);
+`
+
+func analyze(t *testing.T, a *Analyzer, query string) (*core.Analysis, error) {
+	t.Helper()
+	t.Cleanup(func() { a.Close(context.Background()) })
+	return a.Analyze(context.Background(), &ast.RawStmt{Stmt: &ast.SelectStmt{}}, query, []string{schema}, nil)
+}
+
+func TestAnalyze(t *testing.T) {
+	a := New(config.Database{Managed: true})
+	result, err := analyze(t, a, "SELECT id, name, bio, born, count(*) AS n FROM authors WHERE id = ? AND name = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type column struct {
+		Name     string
+		DataType string
+		NotNull  bool
+		Table    string
+	}
+	want := []column{
+		{Name: "id", DataType: "integer", NotNull: true, Table: "main.authors"},
+		{Name: "name", DataType: "varchar", NotNull: true, Table: "main.authors"},
+		{Name: "bio", DataType: "text", Table: "main.authors"},
+		{Name: "born", DataType: "datetime", Table: "main.authors"},
+		// Expressions have no declared type
+		{Name: "n"},
+	}
+	var got []column
+	for _, col := range result.Columns {
+		c := column{Name: col.Name, DataType: col.DataType, NotNull: col.NotNull}
+		if col.Table != nil {
+			c.Table = col.Table.Schema + "." + col.Table.Name
+		}
+		got = append(got, c)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("columns differ (-want +got):\n%s", diff)
+	}
+	if len(result.Params) != 2 {
+		t.Errorf("expected 2 parameters, got %d", len(result.Params))
+	}
+}
+
+func TestAnalyzeOuterJoin(t *testing.T) {
+	for _, tc := range []struct {
+		query string
+		want  []bool
+	}{
+		{"SELECT a.name, b.title FROM authors a JOIN books b ON b.author_id = a.id", []bool{true, true}},
+		{"SELECT a.name, b.title FROM authors a LEFT JOIN books b ON b.author_id = a.id", []bool{true, false}},
+		{"SELECT a.name, b.title FROM authors a RIGHT JOIN books b ON b.author_id = a.id", []bool{false, true}},
+		{"SELECT a.name, b.title FROM authors a FULL JOIN books b ON b.author_id = a.id", []bool{false, false}},
+		{"SELECT a.name, b.title FROM authors a LEFT JOIN (SELECT * FROM books) b ON b.author_id = a.id", []bool{true, false}},
+	} {
+		stmts, err := sqlite.NewParser().Parse(strings.NewReader(tc.query))
+		if err != nil {
+			t.Fatal(err)
+		}
+		a := New(config.Database{Managed: true})
+		t.Cleanup(func() { a.Close(context.Background()) })
+		result, err := a.Analyze(context.Background(), stmts[0].Raw, tc.query, []string{schema}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []bool
+		for _, col := range result.Columns {
+			got = append(got, col.NotNull)
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("%s: NOT NULL differs (-want +got):\n%s", tc.query, diff)
+		}
+	}
+}
+
+func TestAnalyzeError(t *testing.T) {
+	a := New(config.Database{Managed: true})
+	_, err := analyze(t, a, "SELECT missing FROM authors")
+	var serr *sqlerr.Error
+	if !errors.As(err, &serr) {
+		t.Fatalf("expected a *sqlerr.Error, got %v", err)
+	}
+	if serr.Message != "no such column: missing" {
+		t.Errorf("unexpected message: %s", serr.Message)
+	}
+	if serr.Location != len("SELECT ") {
+		t.Errorf("expected location %d, got %d", len("SELECT "), serr.Location)
+	}
+}
+
+func TestAnalyzeMissingDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.db")
+	a := New(config.Database{URI: "file:" + path})
+	if _, err := analyze(t, a, "SELECT 1"); err == nil {
+		t.Fatal("expected an error opening a database that doesn't exist")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("the database file was created: %v", err)
+	}
+}
+
+func TestAnalyzeReadOnly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "authors.db")
+	c, err := open(path, sqlite3.SQLITE_OPEN_READWRITE|sqlite3.SQLITE_OPEN_CREATE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.exec(schema); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	a := New(config.Database{URI: "file:" + path})
+	result, err := analyze(t, a, "SELECT name FROM authors WHERE id = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Columns) != 1 || result.Columns[0].DataType != "varchar" {
+		t.Errorf("unexpected columns: %v", result.Columns)
+	}
+	if err := a.conn.exec("INSERT INTO authors (name) VALUES ('x')"); err == nil {
+		t.Error("expected the database to be opened read-only")
+	}
+}
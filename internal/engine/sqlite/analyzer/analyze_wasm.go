@@ -0,0 +1,27 @@
+//go:build wasm
+
+package analyzer
+
+import (
+	"context"
+	"fmt"
+
+	core "github.com/sqlc-dev/sqlc/internal/analysis"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/named"
+)
+
+type Analyzer struct{}
+
+func New(db config.Database) *Analyzer {
+	return &Analyzer{}
+}
+
+func (a *Analyzer) Analyze(ctx context.Context, n ast.Node, query string, migrations []string, ps *named.ParamSet) (*core.Analysis, error) {
+	return nil, fmt.Errorf("sqlite: database-backed analysis is not supported on wasm")
+}
+
+func (a *Analyzer) Close(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,192 @@
+//go:build !wasm
+
+package analyzer
+
+import (
+	"fmt"
+	"unsafe"
+
+	"modernc.org/libc"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// conn is a minimal wrapper around the SQLite C API. The database/sql driver
+// executes statements as soon as they're queried, while the analyzer only
+// needs to prepare them and read back the statement metadata.
+//
+// The transpiled C code writes out parameters through raw pointers, so they
+// must not point into the Go heap. They're allocated on the stack of the TLS
+// instead, see outPtr.
+type conn struct {
+	tls *libc.TLS
+	db  uintptr
+}
+
+type sqliteError struct {
+	code   int32
+	msg    string
+	offset int32
+}
+
+func (e *sqliteError) Error() string {
+	return e.msg
+}
+
+// open opens the database name, which may be a URI, with the SQLITE_OPEN_*
+// flags.
+func open(name string, flags int32) (*conn, error) {
+	tls := libc.NewTLS()
+	c := &conn{tls: tls}
+
+	cname, err := libc.CString(name)
+	if err != nil {
+		tls.Close()
+		return nil, err
+	}
+	rc := outPtr(tls, &c.db, func(ppDb uintptr) int32 {
+		return sqlite3.Xsqlite3_open_v2(tls, cname, ppDb, flags|sqlite3.SQLITE_OPEN_URI, 0)
+	})
+	libc.Xfree(tls, cname)
+	if rc != sqlite3.SQLITE_OK {
+		err := c.errstr(rc)
+		c.close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// outPtr calls fn with a pointer to C memory for a pointer out parameter,
+// and stores the value fn wrote in out.
+func outPtr(tls *libc.TLS, out *uintptr, fn func(uintptr) int32) int32 {
+	const size = int(unsafe.Sizeof(uintptr(0)))
+	p := tls.Alloc(size)
+	defer tls.Free(size)
+	*(*uintptr)(cPointer(p)) = 0
+	rc := fn(p)
+	*out = *(*uintptr)(cPointer(p))
+	return rc
+}
+
+// cPointer converts the address of C memory to a pointer. The memory isn't
+// managed by the Go runtime, so no Go pointer is hidden from the garbage
+// collector.
+func cPointer(p uintptr) unsafe.Pointer {
+	return unsafe.Add(unsafe.Pointer(nil), p)
+}
+
+// outInt32 is outPtr for an int out parameter.
+func outInt32(tls *libc.TLS, out *int32, fn func(uintptr) int32) int32 {
+	const size = int(unsafe.Sizeof(int32(0)))
+	p := tls.Alloc(size)
+	defer tls.Free(size)
+	*(*int32)(cPointer(p)) = 0
+	rc := fn(p)
+	*out = *(*int32)(cPointer(p))
+	return rc
+}
+
+func (c *conn) errstr(rc int32) error {
+	msg := libc.GoString(sqlite3.Xsqlite3_errstr(c.tls, rc))
+	offset := int32(-1)
+	if c.db != 0 {
+		msg = libc.GoString(sqlite3.Xsqlite3_errmsg(c.tls, c.db))
+		offset = sqlite3.Xsqlite3_error_offset(c.tls, c.db)
+	}
+	return &sqliteError{code: rc, msg: msg, offset: offset}
+}
+
+func (c *conn) exec(query string) error {
+	csql, err := libc.CString(query)
+	if err != nil {
+		return err
+	}
+	defer libc.Xfree(c.tls, csql)
+
+	if rc := sqlite3.Xsqlite3_exec(c.tls, c.db, csql, 0, 0, 0); rc != sqlite3.SQLITE_OK {
+		return c.errstr(rc)
+	}
+	return nil
+}
+
+type column struct {
+	Name     string
+	DeclType string
+	Database string
+	Table    string
+	NotNull  bool
+}
+
+type statement struct {
+	Columns   []column
+	NumParams int
+}
+
+// describe prepares the first statement in query without stepping it and
+// returns the result columns and number of bound parameters.
+func (c *conn) describe(query string) (*statement, error) {
+	csql, err := libc.CString(query)
+	if err != nil {
+		return nil, err
+	}
+	defer libc.Xfree(c.tls, csql)
+
+	var pstmt uintptr
+	rc := outPtr(c.tls, &pstmt, func(ppStmt uintptr) int32 {
+		return sqlite3.Xsqlite3_prepare_v2(c.tls, c.db, csql, -1, ppStmt, 0)
+	})
+	if rc != sqlite3.SQLITE_OK {
+		return nil, c.errstr(rc)
+	}
+	if pstmt == 0 {
+		return nil, fmt.Errorf("sqlite: empty statement")
+	}
+	defer sqlite3.Xsqlite3_finalize(c.tls, pstmt)
+
+	var stmt statement
+	stmt.NumParams = int(sqlite3.Xsqlite3_bind_parameter_count(c.tls, pstmt))
+
+	n := sqlite3.Xsqlite3_column_count(c.tls, pstmt)
+	for i := range n {
+		col := column{
+			Name:     libc.GoString(sqlite3.Xsqlite3_column_name(c.tls, pstmt, i)),
+			DeclType: libc.GoString(sqlite3.Xsqlite3_column_decltype(c.tls, pstmt, i)),
+			Database: libc.GoString(sqlite3.Xsqlite3_column_database_name(c.tls, pstmt, i)),
+			Table:    libc.GoString(sqlite3.Xsqlite3_column_table_name(c.tls, pstmt, i)),
+		}
+		if col.Table != "" {
+			origin := sqlite3.Xsqlite3_column_origin_name(c.tls, pstmt, i)
+			col.NotNull, err = c.notNull(sqlite3.Xsqlite3_column_database_name(c.tls, pstmt, i), sqlite3.Xsqlite3_column_table_name(c.tls, pstmt, i), origin)
+			if err != nil {
+				return nil, err
+			}
+		}
+		stmt.Columns = append(stmt.Columns, col)
+	}
+	return &stmt, nil
+}
+
+func (c *conn) notNull(db, table, column uintptr) (bool, error) {
+	var notNull, primaryKey int32
+	rc := outInt32(c.tls, &notNull, func(pNotNull uintptr) int32 {
+		return outInt32(c.tls, &primaryKey, func(pPrimaryKey uintptr) int32 {
+			return sqlite3.Xsqlite3_table_column_metadata(c.tls, c.db, db, table, column, 0, 0, pNotNull, pPrimaryKey, 0)
+		})
+	})
+	if rc != sqlite3.SQLITE_OK {
+		return false, c.errstr(rc)
+	}
+	// Primary keys are treated as NOT NULL, like the catalog does
+	return notNull != 0 || primaryKey != 0, nil
+}
+
+func (c *conn) close() error {
+	defer c.tls.Close()
+	if c.db == 0 {
+		return nil
+	}
+	if rc := sqlite3.Xsqlite3_close_v2(c.tls, c.db); rc != sqlite3.SQLITE_OK {
+		return c.errstr(rc)
+	}
+	c.db = 0
+	return nil
+}
@@ -33,6 +33,12 @@ func TestUpdate(t *testing.T) {
 								Name: "bar",
 								Type: ast.TypeName{Name: "text"},
 							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
 						},
 					},
 				},
@@ -53,6 +59,12 @@ func TestUpdate(t *testing.T) {
 								Name: "bar",
 								Type: ast.TypeName{Name: "text"},
 							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
 						},
 					},
 				},
@@ -73,6 +85,12 @@ func TestUpdate(t *testing.T) {
 								Name: "bar",
 								Type: ast.TypeName{Name: "text"},
 							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
 							{
 								Name: "baz",
 								Type: ast.TypeName{Name: "bool"},
@@ -97,6 +115,12 @@ func TestUpdate(t *testing.T) {
 								Name: "baz",
 								Type: ast.TypeName{Name: "text"},
 							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
 						},
 					},
 				},
@@ -117,6 +141,12 @@ func TestUpdate(t *testing.T) {
 								Name: "baz",
 								Type: ast.TypeName{Name: "text"},
 							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
 						},
 					},
 				},
@@ -137,6 +167,12 @@ func TestUpdate(t *testing.T) {
 								Name: "bar",
 								Type: ast.TypeName{Name: "text"},
 							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
 						},
 					},
 				},
@@ -158,6 +194,12 @@ func TestUpdate(t *testing.T) {
 								Name: "bar",
 								Type: ast.TypeName{Name: "text"},
 							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
 						},
 					},
 				},
@@ -179,6 +221,12 @@ func TestUpdate(t *testing.T) {
 								Name: "bar",
 								Type: ast.TypeName{Name: "text"},
 							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
 							{
 								Name: "baz",
 								Type: ast.TypeName{Name: "bool"},
@@ -204,6 +252,12 @@ func TestUpdate(t *testing.T) {
 								Name: "baz",
 								Type: ast.TypeName{Name: "text"},
 							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
 						},
 					},
 				},
@@ -225,6 +279,85 @@ func TestUpdate(t *testing.T) {
 								Name: "baz",
 								Type: ast.TypeName{Name: "text"},
 							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			`
+			CREATE TABLE foo (id integer PRIMARY KEY, bar text UNIQUE);
+			CREATE TABLE baz (foo_id integer REFERENCES foo, bar text, CONSTRAINT baz_bar FOREIGN KEY (bar) REFERENCES foo (bar));
+			CREATE INDEX baz_foo_id ON baz (foo_id);
+			CREATE UNIQUE INDEX baz_lower ON baz (lower(bar));
+			DROP INDEX baz_lower;
+			`,
+			&catalog.Schema{
+				Name: "main",
+				Tables: []*catalog.Table{
+					{
+						Rel: &ast.TableName{Name: "foo"},
+						Columns: []*catalog.Column{
+							{
+								Name:      "id",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+							},
+							{
+								Name: "bar",
+								Type: ast.TypeName{Name: "text"},
+							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
+						},
+						Indexes: []*catalog.Index{
+							{Columns: []string{"id"}, Unique: true, Primary: true, Constraint: true},
+							{Columns: []string{"bar"}, Unique: true, Constraint: true},
+						},
+					},
+					{
+						Rel: &ast.TableName{Name: "baz"},
+						Columns: []*catalog.Column{
+							{
+								Name: "foo_id",
+								Type: ast.TypeName{Name: "integer"},
+							},
+							{
+								Name: "bar",
+								Type: ast.TypeName{Name: "text"},
+							},
+							{
+								Name:      "rowid",
+								Type:      ast.TypeName{Name: "integer"},
+								IsNotNull: true,
+								IsHidden:  true,
+							},
+						},
+						Indexes: []*catalog.Index{
+							{Name: "baz_foo_id", Columns: []string{"foo_id"}},
+						},
+						ForeignKeys: []*catalog.ForeignKey{
+							{
+								Columns:    []string{"foo_id"},
+								RefTable:   &ast.TableName{Name: "foo"},
+								RefColumns: []string{"id"},
+							},
+							{
+								Name:       "baz_bar",
+								Columns:    []string{"bar"},
+								RefTable:   &ast.TableName{Name: "foo"},
+								RefColumns: []string{"bar"},
+							},
 						},
 					},
 				},
@@ -267,3 +400,28 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexIfNotExists(t *testing.T) {
+	stmts, err := NewParser().Parse(strings.NewReader(`
+		CREATE TABLE foo (bar text);
+		CREATE TABLE baz (bar text);
+		CREATE INDEX bar_idx ON foo (bar);
+		CREATE INDEX IF NOT EXISTS bar_idx ON baz (bar);
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newTestCatalog()
+	if err := c.Build(stmts); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]int{"foo": 1, "baz": 0} {
+		table, err := c.GetTable(&ast.TableName{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(table.Indexes) != want {
+			t.Errorf("%s: got %d indexes, want %d", name, len(table.Indexes), want)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package sqlite
 
 import (
-	"fmt"
 	"log"
 	"strconv"
 	"strings"
@@ -10,10 +9,13 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/debug"
 	"github.com/sqlc-dev/sqlc/internal/engine/sqlite/parser"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 )
 
 type cc struct {
 	paramCount int
+	// err is set for statements that parse, but that SQLite would reject
+	err error
 }
 
 type node interface {
@@ -42,7 +44,7 @@ func NewIdentifier(t string) *ast.String {
 func (c *cc) convertAlter_table_stmtContext(n *parser.Alter_table_stmtContext) ast.Node {
 	if n.RENAME_() != nil {
 		if newTable, ok := n.New_table_name().(*parser.New_table_nameContext); ok {
-			name := newTable.Any_name().GetText()
+			name := identifier(newTable.Any_name().GetText())
 			return &ast.RenameTableStmt{
 				Table:   parseTableName(n),
 				NewName: &name,
@@ -50,11 +52,11 @@ func (c *cc) convertAlter_table_stmtContext(n *parser.Alter_table_stmtContext) a
 		}
 
 		if newCol, ok := n.GetNew_column_name().(*parser.Column_nameContext); ok {
-			name := newCol.Any_name().GetText()
+			name := identifier(newCol.Any_name().GetText())
 			return &ast.RenameColumnStmt{
 				Table: parseTableName(n),
 				Col: &ast.ColumnRef{
-					Name: n.GetOld_column_name().GetText(),
+					Name: identifier(n.GetOld_column_name().GetText()),
 				},
 				NewName: &name,
 			}
@@ -67,18 +69,21 @@ func (c *cc) convertAlter_table_stmtContext(n *parser.Alter_table_stmtContext) a
 				Table: parseTableName(n),
 				Cmds:  &ast.List{},
 			}
-			name := def.Column_name().GetText()
+			col := columnDef(def)
+			name := col.Colname
 			stmt.Cmds.Items = append(stmt.Cmds.Items, &ast.AlterTableCmd{
 				Name:    &name,
 				Subtype: ast.AT_AddColumn,
-				Def: &ast.ColumnDef{
-					Colname: name,
-					TypeName: &ast.TypeName{
-						Name: def.Type_name().GetText(),
-					},
-					IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
-				},
+				Def:     col,
 			})
+			for _, icon := range def.AllColumn_constraint() {
+				if con := c.convertColumn_constraintContext(name, icon); con != nil {
+					stmt.Cmds.Items = append(stmt.Cmds.Items, &ast.AlterTableCmd{
+						Subtype:    ast.AT_AddConstraint,
+						Constraint: con,
+					})
+				}
+			}
 			return stmt
 		}
 	}
@@ -88,7 +93,7 @@ func (c *cc) convertAlter_table_stmtContext(n *parser.Alter_table_stmtContext) a
 			Table: parseTableName(n),
 			Cmds:  &ast.List{},
 		}
-		name := n.Column_name(0).GetText()
+		name := identifier(n.Column_name(0).GetText())
 		stmt.Cmds.Items = append(stmt.Cmds.Items, &ast.AlterTableCmd{
 			Name:    &name,
 			Subtype: ast.AT_DropColumn,
@@ -111,64 +116,116 @@ func (c *cc) convertCreate_table_stmtContext(n *parser.Create_table_stmtContext)
 		Name:        parseTableName(n),
 		IfNotExists: n.EXISTS_() != nil,
 	}
+	var strict, withoutRowid bool
+	for _, iopt := range n.AllTable_option() {
+		if opt, ok := iopt.(*parser.Table_optionContext); ok {
+			strict = strict || opt.STRICT_() != nil
+			withoutRowid = withoutRowid || opt.WITHOUT_() != nil
+		}
+	}
+	hasRowid := !withoutRowid
 	for _, idef := range n.AllColumn_def() {
 		if def, ok := idef.(*parser.Column_defContext); ok {
-			typeName := "any"
-			if def.Type_name() != nil {
-				typeName = def.Type_name().GetText()
+			col := columnDef(def)
+			if strict && c.err == nil {
+				c.err = strictType(stmt.Name, def)
+			}
+			if strings.EqualFold(col.Colname, "rowid") {
+				hasRowid = false
+			}
+			stmt.Cols = append(stmt.Cols, col)
+			for _, icon := range def.AllColumn_constraint() {
+				if con := c.convertColumn_constraintContext(col.Colname, icon); con != nil {
+					stmt.Constraints = append(stmt.Constraints, con)
+				}
 			}
-			stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
-				Colname:   identifier(def.Column_name().GetText()),
-				IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
-				TypeName:  &ast.TypeName{Name: typeName},
-			})
 		}
 	}
+	for _, icon := range n.AllTable_constraint() {
+		if con := c.convertTable_constraintContext(icon); con != nil {
+			stmt.Constraints = append(stmt.Constraints, con)
+		}
+	}
+	// Every table has a rowid that can be selected by name, unless it's
+	// declared WITHOUT ROWID or a column takes its name
+	if hasRowid {
+		stmt.Cols = append(stmt.Cols, hiddenColumn("rowid", "integer"))
+	}
 	return stmt
 }
 
-func (c *cc) convertCreate_virtual_table_stmtContext(n *parser.Create_virtual_table_stmtContext) ast.Node {
-	switch moduleName := n.Module_name().GetText(); moduleName {
-	case "fts5":
-		// https://www.sqlite.org/fts5.html
-		return c.convertCreate_virtual_table_fts5(n)
-	default:
-		return todo(
-			fmt.Sprintf("create_virtual_table. unsupported module name: %q", moduleName),
-			n,
-		)
+func (c *cc) convertColumn_constraintContext(col string, icon parser.IColumn_constraintContext) *ast.Constraint {
+	n, ok := icon.(*parser.Column_constraintContext)
+	if !ok {
+		return nil
+	}
+	switch {
+	case n.PRIMARY_() != nil:
+		return &ast.Constraint{
+			Contype: ast.ConstrPrimary,
+			Conname: constraintName(n),
+			Keys:    astutils.StringList([]string{col}),
+		}
+	case n.UNIQUE_() != nil:
+		return &ast.Constraint{
+			Contype: ast.ConstrUnique,
+			Conname: constraintName(n),
+			Keys:    astutils.StringList([]string{col}),
+		}
+	case n.Foreign_key_clause() != nil:
+		return foreignKey(constraintName(n), []string{col}, n.Foreign_key_clause())
 	}
+	return nil
 }
 
-func (c *cc) convertCreate_virtual_table_fts5(n *parser.Create_virtual_table_stmtContext) ast.Node {
-	stmt := &ast.CreateTableStmt{
-		Name:        parseTableName(n),
-		IfNotExists: n.EXISTS_() != nil,
+func (c *cc) convertTable_constraintContext(icon parser.ITable_constraintContext) *ast.Constraint {
+	n, ok := icon.(*parser.Table_constraintContext)
+	if !ok {
+		return nil
 	}
-
-	for _, arg := range n.AllModule_argument() {
-		var columnName string
-
-		// For example: CREATE VIRTUAL TABLE tbl_ft USING fts5(b, c UNINDEXED)
-		//   * the 'b' column is parsed like Expr_qualified_column_nameContext
-		//   * the 'c' column is parsed like Column_defContext
-		if columnExpr, ok := arg.Expr().(*parser.Expr_qualified_column_nameContext); ok {
-			columnName = columnExpr.Column_name().GetText()
-		} else if columnDef, ok := arg.Column_def().(*parser.Column_defContext); ok {
-			columnName = columnDef.Column_name().GetText()
+	switch {
+	case n.PRIMARY_() != nil:
+		return &ast.Constraint{
+			Contype: ast.ConstrPrimary,
+			Conname: constraintName(n),
+			Keys:    astutils.StringList(indexedColumns(n.AllIndexed_column())),
 		}
-
-		if columnName != "" {
-			stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
-				Colname: identifier(columnName),
-				// you can not specify any column constraints in fts5, so we pass them manually
-				IsNotNull: true,
-				TypeName:  &ast.TypeName{Name: "text"},
-			})
+	case n.UNIQUE_() != nil:
+		return &ast.Constraint{
+			Contype: ast.ConstrUnique,
+			Conname: constraintName(n),
+			Keys:    astutils.StringList(indexedColumns(n.AllIndexed_column())),
 		}
+	case n.FOREIGN_() != nil:
+		return foreignKey(constraintName(n), columnNames(n.AllColumn_name()), n.Foreign_key_clause())
 	}
+	return nil
+}
 
-	return stmt
+func (c *cc) convertCreate_index_stmtContext(n *parser.Create_index_stmtContext) ast.Node {
+	name := identifier(n.Index_name().GetText())
+	table := identifier(n.Table_name().GetText())
+	rel := &ast.RangeVar{Relname: &table}
+	if n.Schema_name() != nil {
+		schema := n.Schema_name().GetText()
+		rel.Schemaname = &schema
+	}
+	params := &ast.List{}
+	for _, col := range indexedColumns(n.AllIndexed_column()) {
+		elem := &ast.IndexElem{}
+		if col != "" {
+			elem.Name = &col
+		}
+		params.Items = append(params.Items, elem)
+	}
+	return &ast.IndexStmt{
+		Idxname:     &name,
+		Relation:    rel,
+		IndexParams: params,
+		WhereClause: c.convert(n.Expr()),
+		Unique:      n.UNIQUE_() != nil,
+		IfNotExists: n.EXISTS_() != nil,
+	}
 }
 
 func (c *cc) convertCreate_view_stmtContext(n *parser.Create_view_stmtContext) ast.Node {
@@ -231,6 +288,20 @@ func (c *cc) convertDelete_stmtContext(n Delete_stmt) ast.Node {
 			delete.WhereClause = c.convert(n.Expr())
 		}
 
+		// Convert the clauses in the order they appear in the statement so
+		// that parameters are numbered correctly
+		if n, ok := n.(interface {
+			Order_by_stmt() parser.IOrder_by_stmtContext
+		}); ok && n.Order_by_stmt() != nil {
+			if sortClause, ok := c.convertOrderby_stmtContext(n.Order_by_stmt()).(*ast.List); ok {
+				delete.SortClause = sortClause
+			}
+		}
+		if n, ok := n.(interface {
+			Limit_stmt() parser.ILimit_stmtContext
+		}); ok {
+			delete.LimitCount, delete.LimitOffset = c.convertLimit_stmtContext(n.Limit_stmt())
+		}
 		if n, ok := n.(interface {
 			Returning_clause() parser.IReturning_clauseContext
 		}); ok {
@@ -238,12 +309,6 @@ func (c *cc) convertDelete_stmtContext(n Delete_stmt) ast.Node {
 		} else {
 			delete.ReturningList = c.convertReturning_caluseContext(nil)
 		}
-		if n, ok := n.(interface {
-			Limit_stmt() parser.ILimit_stmtContext
-		}); ok {
-			limitCount, _ := c.convertLimit_stmtContext(n.Limit_stmt())
-			delete.LimitCount = limitCount
-		}
 
 		return delete
 	}
@@ -252,18 +317,27 @@ func (c *cc) convertDelete_stmtContext(n Delete_stmt) ast.Node {
 }
 
 func (c *cc) convertDrop_stmtContext(n *parser.Drop_stmtContext) ast.Node {
-	if n.TABLE_() != nil || n.VIEW_() != nil {
-		name := ast.TableName{
-			Name: n.Any_name().GetText(),
-		}
-		if n.Schema_name() != nil {
-			name.Schema = n.Schema_name().GetText()
-		}
+	name := ast.TableName{
+		Name: identifier(n.Any_name().GetText()),
+	}
+	if n.Schema_name() != nil {
+		name.Schema = n.Schema_name().GetText()
+	}
 
+	switch {
+	case n.TABLE_() != nil, n.VIEW_() != nil:
 		return &ast.DropTableStmt{
 			IfExists: n.EXISTS_() != nil,
 			Tables:   []*ast.TableName{&name},
 		}
+	case n.INDEX_() != nil:
+		return &ast.DropIndexStmt{
+			IfExists: n.EXISTS_() != nil,
+			Indexes:  []*ast.TableName{&name},
+		}
+	case n.TRIGGER_() != nil:
+		// Triggers aren't tracked in the catalog, so there is nothing to drop
+		return &ast.TODO{}
 	}
 	return todo("convertDrop_stmtContext", n)
 }
@@ -289,7 +363,7 @@ func (c *cc) convertFuncContext(n *parser.Expr_functionContext) ast.Node {
 				Location: name.GetStart().GetStart(),
 			}
 		} else {
-			return &ast.FuncCall{
+			fn := &ast.FuncCall{
 				Func: &ast.FuncName{
 					Schema: schema,
 					Name:   funcName,
@@ -305,12 +379,49 @@ func (c *cc) convertFuncContext(n *parser.Expr_functionContext) ast.Node {
 				AggDistinct: n.DISTINCT_() != nil,
 				Location:    name.GetStart().GetStart(),
 			}
+			if filter, ok := n.Filter_clause().(*parser.Filter_clauseContext); ok {
+				fn.AggFilter = c.convert(filter.Expr())
+			}
+			if over, ok := n.Over_clause().(*parser.Over_clauseContext); ok {
+				fn.Over = c.convertOver_clauseContext(over)
+			}
+			return fn
 		}
 	}
 
 	return todo("convertFuncContext", n)
 }
 
+func (c *cc) convertOver_clauseContext(n *parser.Over_clauseContext) *ast.WindowDef {
+	def := &ast.WindowDef{
+		PartitionClause: &ast.List{},
+		OrderClause:     &ast.List{},
+		Location:        n.GetStart().GetStart(),
+	}
+	if n.Window_name() != nil {
+		name := identifier(n.Window_name().GetText())
+		def.Name = &name
+	}
+	if n.Base_window_name() != nil {
+		name := identifier(n.Base_window_name().GetText())
+		def.Refname = &name
+	}
+	for _, expr := range n.AllExpr() {
+		def.PartitionClause.Items = append(def.PartitionClause.Items, c.convert(expr))
+	}
+	for _, o := range n.AllOrdering_term() {
+		term, ok := o.(*parser.Ordering_termContext)
+		if !ok {
+			continue
+		}
+		def.OrderClause.Items = append(def.OrderClause.Items, &ast.SortBy{
+			Node:     c.convert(term.Expr()),
+			Location: term.GetStart().GetStart(),
+		})
+	}
+	return def
+}
+
 func (c *cc) convertExprContext(n *parser.ExprContext) ast.Node {
 	return &ast.Expr{}
 }
@@ -356,7 +467,7 @@ func (c *cc) convertComparison(n *parser.Expr_comparisonContext) ast.Node {
 		return &ast.In{
 			Expr:     lexpr,
 			List:     rexprs,
-			Not:      false,
+			Not:      n.NOT_() != nil,
 			Sel:      nil,
 			Location: n.GetStart().GetStart(),
 		}
@@ -511,6 +622,12 @@ func (c *cc) convertMultiSelect_stmtContext(n *parser.Select_stmtContext) ast.No
 		}
 	}
 
+	if orderBy := n.Order_by_stmt(); orderBy != nil {
+		if selectStmt.WindowClause == nil {
+			selectStmt.WindowClause = &ast.List{}
+		}
+		selectStmt.WindowClause.Items = append(selectStmt.WindowClause.Items, c.convertOrderby_stmtContext(orderBy))
+	}
 	limitCount, limitOffset := c.convertLimit_stmtContext(n.Limit_stmt())
 	selectStmt.LimitCount = limitCount
 	selectStmt.LimitOffset = limitOffset
@@ -870,9 +987,8 @@ func (c *cc) convertInsert_stmtContext(n *parser.Insert_stmtContext) ast.Node {
 	}
 
 	insert := &ast.InsertStmt{
-		Relation:      rel,
-		Cols:          c.convertColumnNames(n.AllColumn_name()),
-		ReturningList: c.convertReturning_caluseContext(n.Returning_clause()),
+		Relation: rel,
+		Cols:     c.convertColumnNames(n.AllColumn_name()),
 	}
 
 	if n.Select_stmt() != nil {
@@ -913,9 +1029,86 @@ func (c *cc) convertInsert_stmtContext(n *parser.Insert_stmtContext) ast.Node {
 		}
 	}
 
+	if n.Upsert_clause() != nil {
+		insert.OnConflictClause = c.convertUpsert_clauseContext(n.Upsert_clause())
+	}
+	insert.ReturningList = c.convertReturning_caluseContext(n.Returning_clause())
+
 	return insert
 }
 
+func (c *cc) convertUpsert_clauseContext(n parser.IUpsert_clauseContext) *ast.OnConflictClause {
+	clause := &ast.OnConflictClause{
+		TargetList: &ast.List{},
+		Location:   n.GetStart().GetStart(),
+	}
+	if cols := n.AllIndexed_column(); len(cols) > 0 {
+		elems := &ast.List{}
+		for _, col := range indexedColumns(cols) {
+			elem := &ast.IndexElem{}
+			if col != "" {
+				elem.Name = &col
+			}
+			elems.Items = append(elems.Items, elem)
+		}
+		clause.Infer = &ast.InferClause{
+			IndexElems: elems,
+			Location:   cols[0].GetStart().GetStart(),
+		}
+	}
+
+	// The children are walked in order as the target columns, the assigned
+	// expressions and the WHERE clauses can only be told apart by position
+	var targets []string
+	var afterDo, afterWhere bool
+	for _, child := range n.GetChildren() {
+		switch child := child.(type) {
+		case antlr.TerminalNode:
+			switch child.GetSymbol().GetTokenType() {
+			case parser.SQLiteParserDO_:
+				afterDo = true
+			case parser.SQLiteParserWHERE_:
+				afterWhere = true
+			}
+		case parser.IColumn_nameContext:
+			targets = []string{identifier(child.GetText())}
+		case parser.IColumn_name_listContext:
+			targets = columnNames(child.AllColumn_name())
+		case parser.IExprContext:
+			val := c.convert(child)
+			switch {
+			case afterWhere && !afterDo:
+				clause.Infer.WhereClause = val
+				afterWhere = false
+			case afterWhere:
+				clause.WhereClause = val
+			case len(targets) == 1:
+				clause.TargetList.Items = append(clause.TargetList.Items, &ast.ResTarget{
+					Name:     &targets[0],
+					Val:      val,
+					Location: child.GetStart().GetStart(),
+				})
+			default:
+				if list, ok := val.(*ast.List); ok {
+					val = &ast.RowExpr{Args: list}
+				}
+				for i := range targets {
+					clause.TargetList.Items = append(clause.TargetList.Items, &ast.ResTarget{
+						Name: &targets[i],
+						Val: &ast.MultiAssignRef{
+							Source:   val,
+							Colno:    i + 1,
+							Ncolumns: len(targets),
+						},
+						Location: child.GetStart().GetStart(),
+					})
+				}
+			}
+		}
+	}
+	return clause
+}
+
 func (c *cc) convertColumnNames(cols []parser.IColumn_nameContext) *ast.List {
 	list := &ast.List{Items: []ast.Node{}}
 	for _, c := range cols {
@@ -957,22 +1150,30 @@ func (c *cc) convertTablesOrSubquery(n []parser.ITable_or_subqueryContext) []ast
 
 			tables = append(tables, rv)
 		} else if from.Table_function_name() != nil {
-			rel := from.Table_function_name().GetText()
+			rel := identifier(from.Table_function_name().GetText())
+			schema := ""
+			if from.Schema_name() != nil {
+				schema = from.Schema_name().GetText()
+			}
+			args := &ast.List{}
+			for _, expr := range from.AllExpr() {
+				args.Items = append(args.Items, c.convert(expr))
+			}
 			rf := &ast.RangeFunction{
 				Functions: &ast.List{
 					Items: []ast.Node{
 						&ast.FuncCall{
 							Func: &ast.FuncName{
-								Name: rel,
+								Schema: schema,
+								Name:   rel,
 							},
 							Funcname: &ast.List{
 								Items: []ast.Node{
 									NewIdentifier(rel),
 								},
 							},
-							Args: &ast.List{
-								Items: []ast.Node{&ast.TODO{}},
-							},
+							Args:     args,
+							AggOrder: &ast.List{},
 							Location: from.GetStart().GetStart(),
 						},
 					},
@@ -983,6 +1184,10 @@ func (c *cc) convertTablesOrSubquery(n []parser.ITable_or_subqueryContext) []ast
 				alias := identifier(from.Table_alias().GetText())
 				rf.Alias = &ast.Alias{Aliasname: &alias}
 			}
+			if from.Table_alias_fallback() != nil {
+				alias := identifier(from.Table_alias_fallback().GetText())
+				rf.Alias = &ast.Alias{Aliasname: &alias}
+			}
 
 			tables = append(tables, rf)
 		} else if from.Select_stmt() != nil {
@@ -1073,7 +1278,7 @@ func (c *cc) convertBetweenExpr(n *parser.Expr_betweenContext) ast.Node {
 }
 
 func (c *cc) convertCastExpr(n *parser.Expr_castContext) ast.Node {
-	name := n.Type_name().GetText()
+	name := DataType(n.Type_name().GetText())
 	return &ast.TypeCast{
 		Arg: c.convert(n.Expr()),
 		TypeName: &ast.TypeName{
@@ -1126,6 +1331,9 @@ func (c *cc) convert(node node) ast.Node {
 	case *parser.Attach_stmtContext:
 		return c.convertAttach_stmtContext(n)
 
+	case *parser.Create_index_stmtContext:
+		return c.convertCreate_index_stmtContext(n)
+
 	case *parser.Create_table_stmtContext:
 		return c.convertCreate_table_stmtContext(n)
 
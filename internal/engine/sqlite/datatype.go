@@ -0,0 +1,97 @@
+package sqlite
+
+import (
+	"strconv"
+	"strings"
+)
+
+// knownTypes are the declared types that code generators map to a specific
+// type. They're kept as declared, other types are replaced by their affinity.
+var knownTypes = map[string]struct{}{
+	"any":              {},
+	"bigint":           {},
+	"blob":             {},
+	"bool":             {},
+	"boolean":          {},
+	"character":        {},
+	"clob":             {},
+	"date":             {},
+	"datetime":         {},
+	"decimal":          {},
+	"double":           {},
+	"doubleprecision":  {},
+	"float":            {},
+	"int":              {},
+	"int2":             {},
+	"int8":             {},
+	"integer":          {},
+	"mediumint":        {},
+	"nativecharacter":  {},
+	"nchar":            {},
+	"numeric":          {},
+	"nvarchar":         {},
+	"real":             {},
+	"smallint":         {},
+	"text":             {},
+	"timestamp":        {},
+	"tinyint":          {},
+	"unsignedbigint":   {},
+	"varchar":          {},
+	"varyingcharacter": {},
+}
+
+// strictTypes are the only types allowed in STRICT tables.
+var strictTypes = map[string]struct{}{
+	"any":     {},
+	"blob":    {},
+	"int":     {},
+	"integer": {},
+	"real":    {},
+	"text":    {},
+}
+
+// DataType returns the name sqlc uses for a column declared with the type
+// decl. Whitespace and size arguments are removed, so "VARCHAR(20)" becomes
+// "varchar". Declared types that aren't known are replaced by the type of
+// their affinity, following the rules in
+// https://www.sqlite.org/datatype3.html#determination_of_column_affinity.
+// Types with NUMERIC affinity can hold any value, so they're kept.
+func DataType(decl string) string {
+	name, _ := parseDataType(decl)
+	return name
+}
+
+// parseDataType returns the name of the type decl and the length given as
+// its only argument, if any.
+func parseDataType(decl string) (string, *int) {
+	name := strings.ToLower(strings.Join(strings.Fields(decl), ""))
+	var length *int
+	if i := strings.IndexByte(name, '('); i >= 0 {
+		args := strings.TrimSuffix(name[i+1:], ")")
+		if l, err := strconv.Atoi(args); err == nil {
+			length = &l
+		}
+		name = name[:i]
+	}
+	if name == "" {
+		return "any", nil
+	}
+	if _, ok := knownTypes[name]; ok {
+		return name, length
+	}
+	switch {
+	case strings.Contains(name, "int"):
+		name = "integer"
+	case strings.Contains(name, "char"),
+		strings.Contains(name, "clob"),
+		strings.Contains(name, "text"):
+		name = "text"
+	case strings.Contains(name, "blob"):
+		name = "blob"
+	case strings.Contains(name, "real"),
+		strings.Contains(name, "floa"),
+		strings.Contains(name, "doub"):
+		name = "real"
+	}
+	return name, length
+}
@@ -68,6 +68,9 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 		for _, stmt := range list.AllSql_stmt() {
 			converter := &cc{}
 			out := converter.convert(stmt)
+			if converter.err != nil {
+				return nil, converter.err
+			}
 			if _, ok := out.(*ast.TODO); ok {
 				loc = stmt.GetStop().GetStop() + 2
 				continue
@@ -980,6 +980,77 @@ func defaultSchema(name string) *catalog.Schema {
 			},
 			ReturnType: &ast.TypeName{Name: "real"},
 		},
+		// fts3 and fts4 funcs https://www.sqlite.org/fts3.html#appendix_a
+		{
+			Name: "offsets",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "text"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "text"},
+		},
+		{
+			Name: "matchinfo",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "text"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "blob"},
+		},
+		{
+			Name: "matchinfo",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "text"},
+				},
+				{
+					Type: &ast.TypeName{Name: "text"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "blob"},
+		},
+		// Table-valued JSON functions https://www.sqlite.org/json1.html#jeach
+		jsonTableFunction("json_each"),
+		jsonTableFunction("json_each", "text"),
+		jsonTableFunction("json_tree"),
+		jsonTableFunction("json_tree", "text"),
 	}
 	return s
 }
+
+// jsonTableFunction returns json_each or json_tree. Their rows are described
+// by out arguments, which become the columns of the function in a FROM clause.
+func jsonTableFunction(name string, extraArgs ...string) *catalog.Function {
+	args := []*catalog.Argument{
+		{
+			Type: &ast.TypeName{Name: "any"},
+		},
+	}
+	for _, arg := range extraArgs {
+		args = append(args, &catalog.Argument{
+			Type: &ast.TypeName{Name: arg},
+		})
+	}
+	for _, col := range []struct{ name, typ string }{
+		{"key", "any"},
+		{"value", "any"},
+		{"type", "text"},
+		{"atom", "any"},
+		{"id", "integer"},
+		{"parent", "integer"},
+		{"fullkey", "text"},
+		{"path", "text"},
+	} {
+		args = append(args, &catalog.Argument{
+			Name: col.name,
+			Type: &ast.TypeName{Name: col.typ},
+			Mode: ast.FuncParamOut,
+		})
+	}
+	return &catalog.Function{
+		Name: name,
+		Args: args,
+	}
+}
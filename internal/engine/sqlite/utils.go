@@ -1,8 +1,13 @@
 package sqlite
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/sqlc-dev/sqlc/internal/engine/sqlite/parser"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
 )
 
 type tableNamer interface {
@@ -35,3 +40,110 @@ func hasNotNullConstraint(checks []parser.IColumn_constraintContext) bool {
 	}
 	return false
 }
+
+// columnDef converts the definition of a column. Columns without a declared
+// type can hold values of any type.
+func columnDef(def *parser.Column_defContext) *ast.ColumnDef {
+	col := &ast.ColumnDef{
+		Colname:     identifier(def.Column_name().GetText()),
+		IsNotNull:   hasNotNullConstraint(def.AllColumn_constraint()),
+		IsGenerated: hasGeneratedConstraint(def.AllColumn_constraint()),
+		Default:     defaultValue(def.AllColumn_constraint()),
+		TypeName:    &ast.TypeName{Name: "any"},
+	}
+	if def.Type_name() != nil {
+		col.TypeName.Name, col.Length = parseDataType(def.Type_name().GetText())
+	}
+	return col
+}
+
+// defaultValue returns the source text of a column's DEFAULT constraint, or
+// an empty string if it has none.
+func defaultValue(checks []parser.IColumn_constraintContext) string {
+	for i := range checks {
+		constraint, ok := checks[i].(*parser.Column_constraintContext)
+		if !ok || constraint.DEFAULT_() == nil {
+			continue
+		}
+		start := constraint.DEFAULT_().GetSymbol().GetStop() + 1
+		stop := constraint.GetStop().GetStop()
+		return strings.TrimSpace(constraint.GetStart().GetInputStream().GetText(start, stop))
+	}
+	return ""
+}
+
+// hasGeneratedConstraint reports whether a column is declared with
+// GENERATED ALWAYS AS, or its short form AS.
+func hasGeneratedConstraint(checks []parser.IColumn_constraintContext) bool {
+	for i := range checks {
+		constraint, ok := checks[i].(*parser.Column_constraintContext)
+		if !ok {
+			continue
+		}
+		if constraint.AS_() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// strictType returns an error if a column of a STRICT table doesn't have one
+// of the types allowed in them, with the message SQLite uses.
+func strictType(table *ast.TableName, def *parser.Column_defContext) error {
+	column := identifier(def.Column_name().GetText())
+	if def.Type_name() == nil {
+		return &sqlerr.Error{
+			Message:  fmt.Sprintf("missing datatype for %s.%s", table.Name, column),
+			Location: def.GetStart().GetStart(),
+		}
+	}
+	decl := def.Type_name().GetText()
+	if _, ok := strictTypes[strings.ToLower(decl)]; !ok {
+		return &sqlerr.Error{
+			Message:  fmt.Sprintf("unknown datatype for %s.%s: %q", table.Name, column, decl),
+			Location: def.Type_name().GetStart().GetStart(),
+		}
+	}
+	return nil
+}
+
+func constraintName(n interface{ Name() parser.INameContext }) *string {
+	if n.Name() == nil {
+		return nil
+	}
+	name := identifier(n.Name().GetText())
+	return &name
+}
+
+// indexedColumns returns the names of the indexed columns. Columns that are
+// expressions have an empty name.
+func indexedColumns(cols []parser.IIndexed_columnContext) []string {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		if col.Column_name() != nil {
+			names[i] = identifier(col.Column_name().GetText())
+		} else if expr, ok := col.Expr().(*parser.Expr_qualified_column_nameContext); ok {
+			names[i] = identifier(expr.Column_name().GetText())
+		}
+	}
+	return names
+}
+
+func columnNames(cols []parser.IColumn_nameContext) []string {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = identifier(col.GetText())
+	}
+	return names
+}
+
+func foreignKey(name *string, cols []string, ref parser.IForeign_key_clauseContext) *ast.Constraint {
+	table := identifier(ref.Foreign_table().GetText())
+	return &ast.Constraint{
+		Contype: ast.ConstrForeign,
+		Conname: name,
+		Pktable: &ast.RangeVar{Relname: &table},
+		FkAttrs: astutils.StringList(cols),
+		PkAttrs: astutils.StringList(columnNames(ref.AllColumn_name())),
+	}
+}
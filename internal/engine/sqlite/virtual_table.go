@@ -0,0 +1,226 @@
+package sqlite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/engine/sqlite/parser"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+)
+
+func (c *cc) convertCreate_virtual_table_stmtContext(n *parser.Create_virtual_table_stmtContext) ast.Node {
+	switch moduleName := strings.ToLower(n.Module_name().GetText()); moduleName {
+	case "fts5":
+		// https://www.sqlite.org/fts5.html
+		return c.convertCreate_virtual_table_fts5(n)
+	case "fts3", "fts4":
+		// https://www.sqlite.org/fts3.html
+		return c.convertCreate_virtual_table_fts4(n)
+	case "rtree", "rtree_i32":
+		// https://www.sqlite.org/rtree.html
+		return c.convertCreate_virtual_table_rtree(n, moduleName == "rtree_i32")
+	case "geopoly":
+		// https://www.sqlite.org/geopoly.html
+		return c.convertCreate_virtual_table_geopoly(n)
+	case "dbstat":
+		// https://www.sqlite.org/dbstat.html
+		return c.convertCreate_virtual_table_dbstat(n)
+	default:
+		return todo(
+			fmt.Sprintf("create_virtual_table. unsupported module name: %q", moduleName),
+			n,
+		)
+	}
+}
+
+func (c *cc) convertCreate_virtual_table_fts5(n *parser.Create_virtual_table_stmtContext) ast.Node {
+	stmt := &ast.CreateTableStmt{
+		Name:        parseTableName(n),
+		IfNotExists: n.EXISTS_() != nil,
+	}
+
+	for _, columnName := range moduleColumns(n) {
+		stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
+			Colname: columnName,
+			// you can not specify any column constraints in fts5, so we pass them manually
+			IsNotNull: true,
+			TypeName:  &ast.TypeName{Name: "text"},
+		})
+	}
+
+	stmt.Cols = append(stmt.Cols,
+		hiddenColumn(stmt.Name.Name, "text"),
+		hiddenColumn("rank", "real"),
+		hiddenColumn("rowid", "integer"),
+	)
+	return stmt
+}
+
+func (c *cc) convertCreate_virtual_table_fts4(n *parser.Create_virtual_table_stmtContext) ast.Node {
+	stmt := &ast.CreateTableStmt{
+		Name:        parseTableName(n),
+		IfNotExists: n.EXISTS_() != nil,
+	}
+
+	// Declared types are ignored, every column of a full-text table holds text
+	for _, columnName := range moduleColumns(n) {
+		stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
+			Colname:   columnName,
+			IsNotNull: true,
+			TypeName:  &ast.TypeName{Name: "text"},
+		})
+	}
+
+	stmt.Cols = append(stmt.Cols,
+		hiddenColumn(stmt.Name.Name, "text"),
+		hiddenColumn("docid", "integer"),
+		hiddenColumn("rowid", "integer"),
+	)
+	if languageID, ok := moduleOptions(n)["languageid"]; ok {
+		stmt.Cols = append(stmt.Cols, hiddenColumn(languageID, "integer"))
+	}
+	return stmt
+}
+
+func (c *cc) convertCreate_virtual_table_rtree(n *parser.Create_virtual_table_stmtContext, i32 bool) ast.Node {
+	stmt := &ast.CreateTableStmt{
+		Name:        parseTableName(n),
+		IfNotExists: n.EXISTS_() != nil,
+	}
+
+	coordinate := "real"
+	if i32 {
+		coordinate = "integer"
+	}
+	for i, arg := range n.AllModule_argument() {
+		// Auxiliary columns are prefixed with a "+" and can hold any value
+		if unary, ok := arg.Expr().(*parser.Expr_unaryContext); ok {
+			if columnExpr, ok := unary.Expr().(*parser.Expr_qualified_column_nameContext); ok {
+				stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
+					Colname:  identifier(columnExpr.Column_name().GetText()),
+					TypeName: &ast.TypeName{Name: "any"},
+				})
+			}
+			continue
+		}
+		columnName := moduleColumn(arg)
+		if columnName == "" {
+			continue
+		}
+		typeName := coordinate
+		if i == 0 {
+			typeName = "integer"
+		}
+		stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
+			Colname:   columnName,
+			IsNotNull: true,
+			TypeName:  &ast.TypeName{Name: typeName},
+		})
+	}
+	return stmt
+}
+
+func (c *cc) convertCreate_virtual_table_geopoly(n *parser.Create_virtual_table_stmtContext) ast.Node {
+	stmt := &ast.CreateTableStmt{
+		Name:        parseTableName(n),
+		IfNotExists: n.EXISTS_() != nil,
+		Cols: []*ast.ColumnDef{
+			{
+				Colname:   "_shape",
+				IsNotNull: true,
+				TypeName:  &ast.TypeName{Name: "blob"},
+			},
+		},
+	}
+
+	for _, columnName := range moduleColumns(n) {
+		stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
+			Colname:  columnName,
+			TypeName: &ast.TypeName{Name: "any"},
+		})
+	}
+
+	stmt.Cols = append(stmt.Cols, hiddenColumn("rowid", "integer"))
+	return stmt
+}
+
+func (c *cc) convertCreate_virtual_table_dbstat(n *parser.Create_virtual_table_stmtContext) ast.Node {
+	column := func(name, typeName string, notNull bool) *ast.ColumnDef {
+		return &ast.ColumnDef{
+			Colname:   name,
+			IsNotNull: notNull,
+			TypeName:  &ast.TypeName{Name: typeName},
+		}
+	}
+	// The path, page type and offset are NULL when the pages are aggregated
+	return &ast.CreateTableStmt{
+		Name:        parseTableName(n),
+		IfNotExists: n.EXISTS_() != nil,
+		Cols: []*ast.ColumnDef{
+			column("name", "text", true),
+			column("path", "text", false),
+			column("pageno", "integer", true),
+			column("pagetype", "text", false),
+			column("ncell", "integer", true),
+			column("payload", "integer", true),
+			column("unused", "integer", true),
+			column("mx_payload", "integer", true),
+			column("pgoffset", "integer", false),
+			column("pgsize", "integer", true),
+			hiddenColumn("schema", "text"),
+			hiddenColumn("aggregate", "boolean"),
+		},
+	}
+}
+
+// moduleColumns returns the names of the columns passed to a module, skipping
+// its options
+func moduleColumns(n *parser.Create_virtual_table_stmtContext) []string {
+	var names []string
+	for _, arg := range n.AllModule_argument() {
+		if name := moduleColumn(arg); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func moduleColumn(arg parser.IModule_argumentContext) string {
+	// For example: CREATE VIRTUAL TABLE tbl_ft USING fts5(b, c UNINDEXED)
+	//   * the 'b' column is parsed like Expr_qualified_column_nameContext
+	//   * the 'c' column is parsed like Column_defContext
+	if columnExpr, ok := arg.Expr().(*parser.Expr_qualified_column_nameContext); ok {
+		return identifier(columnExpr.Column_name().GetText())
+	}
+	if columnDef, ok := arg.Column_def().(*parser.Column_defContext); ok {
+		return identifier(columnDef.Column_name().GetText())
+	}
+	return ""
+}
+
+// moduleOptions returns the key=value arguments passed to a module
+func moduleOptions(n *parser.Create_virtual_table_stmtContext) map[string]string {
+	options := map[string]string{}
+	for _, arg := range n.AllModule_argument() {
+		expr, ok := arg.Expr().(*parser.Expr_comparisonContext)
+		if !ok || expr.ASSIGN() == nil {
+			continue
+		}
+		key := strings.ToLower(expr.Expr(0).GetText())
+		value := expr.Expr(1).GetText()
+		if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+			value = value[1 : len(value)-1]
+		}
+		options[key] = identifier(value)
+	}
+	return options
+}
+
+func hiddenColumn(name, typeName string) *ast.ColumnDef {
+	return &ast.ColumnDef{
+		Colname:   name,
+		IsNotNull: true,
+		IsHidden:  true,
+		TypeName:  &ast.TypeName{Name: typeName},
+	}
+}
@@ -164,17 +164,17 @@ func (r *Runner) loadAndCompileWASM(ctx context.Context, cache string, expected
 	return &runtimeAndCode{rt: rt, code: code}, nil
 }
 
-// removePGCatalog removes the pg_catalog schema from the request. There is a
-// mysterious (reason unknown) bug with wasm plugins when a large amount of
-// tables (like there are in the catalog) are sent.
+// removePGCatalog removes the pg_catalog schema from the catalog of a request.
+// There is a mysterious (reason unknown) bug with wasm plugins when a large
+// amount of tables (like there are in the catalog) are sent.
 // @see https://github.com/sqlc-dev/sqlc/pull/1748
-func removePGCatalog(req *plugin.GenerateRequest) {
-	if req.Catalog == nil || req.Catalog.Schemas == nil {
+func removePGCatalog(catalog *plugin.Catalog) {
+	if catalog == nil || catalog.Schemas == nil {
 		return
 	}
 
-	filtered := make([]*plugin.Schema, 0, len(req.Catalog.Schemas))
-	for _, schema := range req.Catalog.Schemas {
+	filtered := make([]*plugin.Schema, 0, len(catalog.Schemas))
+	for _, schema := range catalog.Schemas {
 		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
 			continue
 		}
@@ -182,7 +182,7 @@ func removePGCatalog(req *plugin.GenerateRequest) {
 		filtered = append(filtered, schema)
 	}
 
-	req.Catalog.Schemas = filtered
+	catalog.Schemas = filtered
 }
 
 func (r *Runner) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
@@ -192,10 +192,11 @@ func (r *Runner) Invoke(ctx context.Context, method string, args any, reply any,
 	}
 
 	// Remove the pg_catalog schema. Its sheer size causes unknown issues with wasm plugins
-	genReq, ok := req.(*plugin.GenerateRequest)
-	if ok {
-		removePGCatalog(genReq)
-		req = genReq
+	switch req := req.(type) {
+	case *plugin.GenerateRequest:
+		removePGCatalog(req.Catalog)
+	case *plugin.VetRequest:
+		removePGCatalog(req.Catalog)
 	}
 
 	stdinBlob, err := proto.Marshal(req)
@@ -234,7 +235,7 @@ func (r *Runner) Invoke(ctx context.Context, method string, args any, reply any,
 
 	resp, ok := reply.(protoreflect.ProtoMessage)
 	if !ok {
-		return fmt.Errorf("reply isn't a GenerateResponse")
+		return fmt.Errorf("reply isn't a protoreflect.ProtoMessage")
 	}
 
 	if err := proto.Unmarshal(stdoutBlob, resp); err != nil {
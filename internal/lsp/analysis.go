@@ -0,0 +1,414 @@
+package lsp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/migrations"
+	"github.com/sqlc-dev/sqlc/internal/multierr"
+	"github.com/sqlc-dev/sqlc/internal/opts"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+// analysis is the compiled package of a document
+type analysis struct {
+	dir      string
+	path     string
+	sql      config.SQL
+	compiler *compiler.Compiler
+	// The queries of the document that compiled
+	queries []*compiler.Query
+	errs    []*multierr.FileError
+}
+
+// analyze compiles the package that has path as one of its schema or query
+// files, with src as the contents of path. Schema files are read from disk,
+// so edits to them are picked up once they're saved.
+func analyze(dir string, conf *config.Config, path, src string) (*analysis, error) {
+	for _, pkg := range conf.SQL {
+		sql := pkg
+		sql.Schema = join(dir, pkg.Schema)
+		sql.Queries = join(dir, pkg.Queries)
+		// Only the built-in analysis is fast enough to run on every edit
+		sql.Database = nil
+
+		schema, _ := sqlpath.Glob(sql.Schema)
+		queries, _ := sqlpath.Glob(sql.Queries)
+		isQuery := slices.Contains(queries, path)
+		if !isQuery && !slices.Contains(schema, path) {
+			continue
+		}
+
+		c, err := compiler.NewCompiler(sql, config.Combine(*conf, sql))
+		if err != nil {
+			return nil, err
+		}
+		a := &analysis{dir: dir, path: path, sql: sql, compiler: c}
+		if err := c.ParseCatalog(sql.Schema); err != nil {
+			a.addErr(err)
+		}
+		if isQuery {
+			qs, err := c.ParseQuerySource(path, src, opts.Parser{})
+			a.queries = qs
+			if err != nil {
+				a.addErr(err)
+			}
+		}
+		return a, nil
+	}
+	return nil, nil
+}
+
+func join(dir string, paths []string) []string {
+	joined := make([]string, 0, len(paths))
+	for _, p := range paths {
+		joined = append(joined, filepath.Join(dir, p))
+	}
+	return joined
+}
+
+func (a *analysis) addErr(err error) {
+	if merr, ok := err.(*multierr.Error); ok {
+		a.errs = append(a.errs, merr.Errs()...)
+		return
+	}
+	a.errs = append(a.errs, &multierr.FileError{Line: 1, Column: 1, Err: err})
+}
+
+// diagnostics converts the errors of the package. Errors in other files, like
+// a broken schema, are reported at the start of the document.
+func (a *analysis) diagnostics(src string) []Diagnostic {
+	diags := []Diagnostic{}
+	for _, e := range a.errs {
+		d := Diagnostic{
+			Severity: SeverityError,
+			Source:   "sqlc",
+			Message:  e.Err.Error(),
+		}
+		if e.Filename == a.path {
+			off := lineOffset(src, e.Line, e.Column)
+			start, end := word(src, off, false)
+			if start == end {
+				end = min(off+1, len(src))
+				start = min(off, end)
+			}
+			d.Range = Range{Start: position(src, start), End: position(src, end)}
+		} else if e.Filename != "" {
+			name, err := filepath.Rel(a.dir, e.Filename)
+			if err != nil {
+				name = e.Filename
+			}
+			d.Message = fmt.Sprintf("%s:%d:%d: %s", name, e.Line, e.Column, e.Err)
+		}
+		diags = append(diags, d)
+	}
+	return diags
+}
+
+var macros = []CompletionItem{
+	{Label: "sqlc.arg", Kind: CompletionKindFunction, Detail: "sqlc.arg(name) names a parameter"},
+	{Label: "sqlc.narg", Kind: CompletionKindFunction, Detail: "sqlc.narg(name) names a nullable parameter"},
+	{Label: "sqlc.slice", Kind: CompletionKindFunction, Detail: "sqlc.slice(name) expands to a list of parameters"},
+	{Label: "sqlc.embed", Kind: CompletionKindFunction, Detail: "sqlc.embed(table) embeds a table's columns as a struct"},
+	{Label: "sqlc.paginate", Kind: CompletionKindFunction, Detail: "sqlc.paginate(col, ...) adds keyset pagination"},
+}
+
+// complete suggests the sqlc macros and the tables and columns of the
+// schema. After `table.` or `alias.` only the columns of that table are
+// suggested, after `sqlc.` only the macros.
+func (a *analysis) complete(src string, off int) []CompletionItem {
+	start, _ := word(src, off, true)
+	prefix := src[start:off]
+	items := []CompletionItem{}
+	if i := strings.LastIndex(prefix, "."); i >= 0 {
+		qual := prefix[:i]
+		qual = qual[strings.LastIndex(qual, ".")+1:]
+		if strings.EqualFold(qual, "sqlc") {
+			for _, m := range macros {
+				m.Label = strings.TrimPrefix(m.Label, "sqlc.")
+				items = append(items, m)
+			}
+			return items
+		}
+		if t := a.table(src, qual); t != nil {
+			for _, col := range t.Columns {
+				items = append(items, CompletionItem{Label: col.Name, Kind: CompletionKindField, Detail: columnType(col)})
+			}
+		}
+		return items
+	}
+
+	items = append(items, macros...)
+	seen := map[string]bool{}
+	for _, t := range a.tables() {
+		items = append(items, CompletionItem{Label: a.tableName(t), Kind: CompletionKindClass, Detail: "table"})
+		for _, col := range t.Columns {
+			if seen[col.Name] {
+				continue
+			}
+			seen[col.Name] = true
+			items = append(items, CompletionItem{
+				Label:  col.Name,
+				Kind:   CompletionKindField,
+				Detail: fmt.Sprintf("%s.%s %s", t.Rel.Name, col.Name, columnType(col)),
+			})
+		}
+	}
+	return items
+}
+
+// hover describes the table under the cursor, or the parameters and result
+// columns of the query under it
+func (a *analysis) hover(src string, off int) *Hover {
+	start, end := word(src, off, false)
+	if start < end {
+		if t := a.lookup(src[start:end]); t != nil {
+			var b strings.Builder
+			fmt.Fprintf(&b, "```sql\nTABLE %s (\n", a.tableName(t))
+			for i, col := range t.Columns {
+				sep := ","
+				if i == len(t.Columns)-1 {
+					sep = ""
+				}
+				fmt.Fprintf(&b, "  %s %s%s\n", col.Name, columnType(col), sep)
+			}
+			b.WriteString(")\n```")
+			rng := Range{Start: position(src, start), End: position(src, end)}
+			return &Hover{Contents: MarkupContent{Kind: "markdown", Value: b.String()}, Range: &rng}
+		}
+	}
+
+	q := a.query(off)
+	if q == nil {
+		return nil
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s** `%s`\n", q.Metadata.Name, q.Metadata.Cmd)
+	if len(q.Params) > 0 {
+		b.WriteString("\nParameters:\n")
+		for _, p := range q.Params {
+			name := p.Column.Name
+			if name == "" {
+				name = fmt.Sprintf("$%d", p.Number)
+			}
+			fmt.Fprintf(&b, "- `%s %s`\n", name, queryColumnType(p.Column))
+		}
+	}
+	if len(q.Columns) > 0 {
+		b.WriteString("\nColumns:\n")
+		for _, col := range q.Columns {
+			name := col.Name
+			if col.EmbedTable != nil {
+				name = "sqlc.embed(" + col.EmbedTable.Name + ")"
+			}
+			fmt.Fprintf(&b, "- `%s %s`\n", name, queryColumnType(col))
+		}
+	}
+	return &Hover{Contents: MarkupContent{Kind: "markdown", Value: b.String()}}
+}
+
+// definition finds the CREATE TABLE statement of the table, or the column
+// definition of the column, under the cursor
+func (a *analysis) definition(src string, off int) *Location {
+	// A qualified name up to the end of the part under the cursor
+	start, _ := word(src, off, true)
+	_, end := word(src, off, false)
+	if start >= end {
+		return nil
+	}
+	parts := strings.Split(src[start:end], ".")
+	name := parts[len(parts)-1]
+	if len(parts) == 1 {
+		if t := a.lookup(name); t != nil {
+			return a.locate(t, "")
+		}
+	}
+
+	var candidates []*catalog.Table
+	if len(parts) > 1 {
+		if t := a.table(src, parts[len(parts)-2]); t != nil {
+			candidates = append(candidates, t)
+		}
+	} else {
+		// Prefer the tables the document reads from
+		candidates = append(candidates, a.referenced(src)...)
+		candidates = append(candidates, a.tables()...)
+	}
+	for _, t := range candidates {
+		for _, col := range t.Columns {
+			if col.Name == name {
+				return a.locate(t, name)
+			}
+		}
+	}
+	return nil
+}
+
+// locate finds a table, or one of its columns, in the schema files
+func (a *analysis) locate(t *catalog.Table, column string) *Location {
+	files, err := sqlpath.Glob(a.sql.Schema)
+	if err != nil {
+		return nil
+	}
+	files, err = migrations.Order(files)
+	if err != nil {
+		return nil
+	}
+	for _, file := range files {
+		blob, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		// Rollback statements are blanked out line by line, so offsets into
+		// contents map to the same lines and columns of the file
+		contents := migrations.RemoveRollbackStatements(string(blob))
+		stmts, err := a.compiler.Parser().Parse(strings.NewReader(contents))
+		if err != nil {
+			continue
+		}
+		for _, stmt := range stmts {
+			create, ok := stmt.Raw.Stmt.(*ast.CreateTableStmt)
+			if !ok || create.Name == nil || !strings.EqualFold(create.Name.Name, t.Rel.Name) {
+				continue
+			}
+			if create.Name.Schema != "" && t.Rel.Schema != "" && create.Name.Schema != t.Rel.Schema {
+				continue
+			}
+			stmtStart := stmt.Raw.StmtLocation
+			stmtEnd := len(contents)
+			if stmt.Raw.StmtLen > 0 {
+				stmtEnd = min(stmtStart+stmt.Raw.StmtLen, len(contents))
+			}
+			text := contents[stmtStart:stmtEnd]
+			pattern := `(?i)\bcreate\b`
+			if column != "" {
+				pattern = `(?i)[(,]\s*["` + "`" + `]?(` + regexp.QuoteMeta(column) + `)\b`
+			}
+			loc := regexp.MustCompile(pattern).FindStringSubmatchIndex(text)
+			if loc == nil {
+				continue
+			}
+			begin, finish := loc[0], loc[1]
+			if column != "" {
+				begin, finish = loc[2], loc[3]
+			}
+			return &Location{
+				URI: pathURI(file),
+				Range: Range{
+					Start: position(contents, stmtStart+begin),
+					End:   position(contents, stmtStart+finish),
+				},
+			}
+		}
+	}
+	return nil
+}
+
+// query returns the compiled query at an offset of the document
+func (a *analysis) query(off int) *compiler.Query {
+	for _, q := range a.queries {
+		if q.RawStmt == nil {
+			continue
+		}
+		start := q.RawStmt.StmtLocation
+		if off < start {
+			continue
+		}
+		if q.RawStmt.StmtLen == 0 || off <= start+q.RawStmt.StmtLen {
+			return q
+		}
+	}
+	return nil
+}
+
+// tables returns the user-defined tables in the catalog
+func (a *analysis) tables() []*catalog.Table {
+	var tables []*catalog.Table
+	for _, s := range a.compiler.Catalog().Schemas {
+		if s.Name == "pg_catalog" || s.Name == "information_schema" {
+			continue
+		}
+		tables = append(tables, s.Tables...)
+	}
+	return tables
+}
+
+func (a *analysis) tableName(t *catalog.Table) string {
+	if t.Rel.Schema == "" || t.Rel.Schema == a.compiler.Catalog().DefaultSchema {
+		return t.Rel.Name
+	}
+	return t.Rel.Schema + "." + t.Rel.Name
+}
+
+// lookup finds a table by name
+func (a *analysis) lookup(name string) *catalog.Table {
+	for _, t := range a.tables() {
+		if strings.EqualFold(t.Rel.Name, name) || strings.EqualFold(a.tableName(t), name) {
+			return t
+		}
+	}
+	return nil
+}
+
+// The tables a statement reads from, with their aliases
+var fromClause = regexp.MustCompile(`(?i)\b(?:from|join|update|into)\s+([\w."]+)(?:\s+(?:as\s+)?(\w+))?`)
+
+// table finds a table by name or by an alias given to it in the document
+func (a *analysis) table(src, name string) *catalog.Table {
+	if t := a.lookup(name); t != nil {
+		return t
+	}
+	for _, m := range fromClause.FindAllStringSubmatch(src, -1) {
+		if strings.EqualFold(m[2], name) {
+			return a.lookup(strings.ReplaceAll(m[1], `"`, ""))
+		}
+	}
+	return nil
+}
+
+// referenced returns the tables named in the FROM clauses of the document
+func (a *analysis) referenced(src string) []*catalog.Table {
+	var tables []*catalog.Table
+	for _, m := range fromClause.FindAllStringSubmatch(src, -1) {
+		if t := a.lookup(strings.ReplaceAll(m[1], `"`, "")); t != nil && !slices.Contains(tables, t) {
+			tables = append(tables, t)
+		}
+	}
+	return tables
+}
+
+func columnType(col *catalog.Column) string {
+	typ := col.Type.Name
+	if col.Type.Schema != "" && col.Type.Schema != "pg_catalog" {
+		typ = col.Type.Schema + "." + typ
+	}
+	if col.IsArray {
+		typ += "[]"
+	}
+	if col.IsNotNull {
+		typ += " not null"
+	}
+	return typ
+}
+
+func queryColumnType(col *compiler.Column) string {
+	typ := col.DataType
+	if col.EmbedTable != nil {
+		typ = col.EmbedTable.Name
+	}
+	if col.IsArray || col.IsSqlcSlice {
+		typ += "[]"
+	}
+	if col.NotNull {
+		typ += " not null"
+	}
+	return typ
+}
@@ -0,0 +1,249 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/info"
+)
+
+// Server is a language server for the schema and query files of a sqlc
+// project. It speaks LSP over a pair of streams, usually stdin and stdout.
+//
+// Documents are analyzed with the built-in compiler. Databases configured
+// for query analysis are never connected to, so edits can be checked on
+// every keystroke.
+type Server struct {
+	// Dir is the directory the paths in the configuration are relative to
+	Dir string
+	// Config reads the configuration. It's called for every request, so
+	// edits to the configuration file apply right away.
+	Config func() (*config.Config, error)
+
+	conn     *conn
+	docs     map[string]string
+	shutdown bool
+}
+
+// Serve handles messages until the client sends exit or r is closed
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+	s.docs = map[string]string{}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		msg, err := s.conn.read()
+		if err != nil {
+			var rerr *responseError
+			if errors.As(err, &rerr) {
+				if err := s.conn.write(response{JSONRPC: "2.0", Error: rerr}); err != nil {
+					return err
+				}
+				continue
+			}
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return errors.New("exit before shutdown")
+			}
+			return nil
+		}
+		result, err := s.handle(msg)
+		if msg.ID == nil {
+			// Notifications have no response, errors included
+			continue
+		}
+		resp := response{JSONRPC: "2.0", ID: msg.ID, Result: result}
+		if err != nil {
+			resp.Result = nil
+			resp.Error = &responseError{Code: codeInternalError, Message: err.Error()}
+			errors.As(err, &resp.Error)
+		}
+		if err := s.conn.write(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(msg *message) (any, error) {
+	switch msg.Method {
+	case "initialize":
+		return InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync: TextDocumentSyncOptions{
+					OpenClose: true,
+					Change:    TextDocumentSyncFull,
+					Save:      true,
+				},
+				CompletionProvider: CompletionOptions{TriggerCharacters: []string{"."}},
+				HoverProvider:      true,
+				DefinitionProvider: true,
+			},
+			ServerInfo: ServerInfo{Name: "sqlc", Version: info.Version},
+		}, nil
+
+	case "initialized":
+		return nil, nil
+
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := unmarshal(msg, &params); err != nil {
+			return nil, err
+		}
+		s.docs[params.TextDocument.URI] = params.TextDocument.Text
+		return nil, s.publish(params.TextDocument.URI)
+
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := unmarshal(msg, &params); err != nil {
+			return nil, err
+		}
+		if n := len(params.ContentChanges); n > 0 {
+			s.docs[params.TextDocument.URI] = params.ContentChanges[n-1].Text
+		}
+		return nil, s.publish(params.TextDocument.URI)
+
+	case "textDocument/didSave":
+		var params DidSaveTextDocumentParams
+		if err := unmarshal(msg, &params); err != nil {
+			return nil, err
+		}
+		// Saving a schema file changes the diagnostics of every open query
+		for uri := range s.docs {
+			if err := s.publish(uri); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := unmarshal(msg, &params); err != nil {
+			return nil, err
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return nil, s.conn.write(notification{
+			JSONRPC: "2.0",
+			Method:  "textDocument/publishDiagnostics",
+			Params:  PublishDiagnosticsParams{URI: params.TextDocument.URI, Diagnostics: []Diagnostic{}},
+		})
+
+	case "textDocument/completion":
+		var params TextDocumentPositionParams
+		if err := unmarshal(msg, &params); err != nil {
+			return nil, err
+		}
+		a, src, err := s.analyze(params.TextDocument.URI)
+		if err != nil || a == nil {
+			return []CompletionItem{}, err
+		}
+		return a.complete(src, offset(src, params.Position)), nil
+
+	case "textDocument/hover":
+		var params TextDocumentPositionParams
+		if err := unmarshal(msg, &params); err != nil {
+			return nil, err
+		}
+		a, src, err := s.analyze(params.TextDocument.URI)
+		if err != nil || a == nil {
+			return nil, err
+		}
+		return a.hover(src, offset(src, params.Position)), nil
+
+	case "textDocument/definition":
+		var params TextDocumentPositionParams
+		if err := unmarshal(msg, &params); err != nil {
+			return nil, err
+		}
+		a, src, err := s.analyze(params.TextDocument.URI)
+		if err != nil || a == nil {
+			return nil, err
+		}
+		return a.definition(src, offset(src, params.Position)), nil
+
+	default:
+		if strings.HasPrefix(msg.Method, "$/") {
+			// Optional notifications and requests may be ignored
+			return nil, nil
+		}
+		return nil, &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not found: %s", msg.Method)}
+	}
+}
+
+// publish sends the diagnostics of a document to the client
+func (s *Server) publish(uri string) error {
+	diags := []Diagnostic{}
+	a, src, err := s.analyze(uri)
+	if err != nil {
+		diags = append(diags, Diagnostic{
+			Severity: SeverityError,
+			Source:   "sqlc",
+			Message:  err.Error(),
+		})
+	} else if a != nil {
+		diags = a.diagnostics(src)
+	}
+	return s.conn.write(notification{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params:  PublishDiagnosticsParams{URI: uri, Diagnostics: diags},
+	})
+}
+
+// analyze compiles the package a document belongs to. It returns a nil
+// analysis for documents that aren't part of any package.
+func (s *Server) analyze(uri string) (*analysis, string, error) {
+	path, err := uriPath(uri)
+	if err != nil {
+		return nil, "", err
+	}
+	src, ok := s.docs[uri]
+	if !ok {
+		return nil, "", nil
+	}
+	conf, err := s.Config()
+	if err != nil {
+		return nil, "", err
+	}
+	a, err := analyze(s.Dir, conf, path, src)
+	return a, src, err
+}
+
+func unmarshal(msg *message, v any) error {
+	if err := json.Unmarshal(msg.Params, v); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+func uriPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("unsupported URI scheme: %s", u.Scheme)
+	}
+	return filepath.Clean(filepath.FromSlash(u.Path)), nil
+}
+
+func pathURI(path string) string {
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(path)}
+	return u.String()
+}
@@ -0,0 +1,192 @@
+package lsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+const testSchema = `CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL,
+  bio  text
+);
+`
+
+type client struct {
+	t   *testing.T
+	w   io.Writer
+	r   *textproto.Reader
+	ids int
+}
+
+func (c *client) send(method string, params any, id bool) {
+	msg := map[string]any{"jsonrpc": "2.0", "method": method, "params": params}
+	if id {
+		c.ids++
+		msg["id"] = c.ids
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if _, err := io.WriteString(c.w, "Content-Length: "+strconv.Itoa(len(body))+"\r\n\r\n"+string(body)); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *client) recv(v any) {
+	header, err := c.r.ReadMIMEHeader()
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(c.r.R, body); err != nil {
+		c.t.Fatal(err)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *client) call(method string, params any, result any) {
+	c.send(method, params, true)
+	var resp struct {
+		Result json.RawMessage `json:"result"`
+		Error  *responseError  `json:"error"`
+	}
+	c.recv(&resp)
+	if resp.Error != nil {
+		c.t.Fatalf("%s: %s", method, resp.Error.Message)
+	}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *client) diagnostics() []Diagnostic {
+	var n struct {
+		Method string                   `json:"method"`
+		Params PublishDiagnosticsParams `json:"params"`
+	}
+	c.recv(&n)
+	if n.Method != "textDocument/publishDiagnostics" {
+		c.t.Fatalf("expected diagnostics, got %s", n.Method)
+	}
+	return n.Params.Diagnostics
+}
+
+func TestServer(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, contents string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("schema.sql", testSchema)
+	write("query.sql", "")
+	conf := &config.Config{
+		Version: "2",
+		SQL: []config.SQL{{
+			Engine:  config.EnginePostgreSQL,
+			Schema:  config.Paths{"schema.sql"},
+			Queries: config.Paths{"query.sql"},
+		}},
+	}
+
+	inr, inw := io.Pipe()
+	outr, outw := io.Pipe()
+	srv := &Server{Dir: dir, Config: func() (*config.Config, error) { return conf, nil }}
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.Serve(context.Background(), inr, outw)
+		outw.Close()
+	}()
+	c := &client{t: t, w: inw, r: textproto.NewReader(bufio.NewReader(outr))}
+
+	var init InitializeResult
+	c.call("initialize", map[string]any{}, &init)
+	if !init.Capabilities.HoverProvider || !init.Capabilities.DefinitionProvider {
+		t.Fatalf("missing capabilities: %+v", init.Capabilities)
+	}
+	c.send("initialized", map[string]any{}, false)
+
+	uri := pathURI(filepath.Join(dir, "query.sql"))
+	query := "-- name: GetAuthor :one\nSELECT id, nme FROM authors WHERE id = $1;\n"
+	c.send("textDocument/didOpen", DidOpenTextDocumentParams{
+		TextDocument: TextDocumentItem{URI: uri, LanguageID: "sql", Text: query},
+	}, false)
+	diags := c.diagnostics()
+	if len(diags) != 1 || !strings.Contains(diags[0].Message, `column "nme" does not exist`) {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if want := (Range{Start: Position{1, 11}, End: Position{1, 14}}); diags[0].Range != want {
+		t.Errorf("diagnostic range: got %+v, want %+v", diags[0].Range, want)
+	}
+
+	query = "-- name: GetAuthor :one\nSELECT a.id, name FROM authors a WHERE id = $1;\n"
+	c.send("textDocument/didChange", DidChangeTextDocumentParams{
+		TextDocument:   TextDocumentIdentifier{URI: uri},
+		ContentChanges: []TextDocumentContentChangeEvent{{Text: query}},
+	}, false)
+	if diags := c.diagnostics(); len(diags) != 0 {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+
+	var hover Hover
+	c.call("textDocument/hover", TextDocumentPositionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Position:     Position{Line: 1, Character: 2},
+	}, &hover)
+	for _, want := range []string{"**GetAuthor** `:one`", "- `id bigserial not null`", "- `name text not null`"} {
+		if !strings.Contains(hover.Contents.Value, want) {
+			t.Errorf("hover is missing %q:\n%s", want, hover.Contents.Value)
+		}
+	}
+
+	var items []CompletionItem
+	c.call("textDocument/completion", TextDocumentPositionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Position:     Position{Line: 1, Character: 9},
+	}, &items)
+	var labels []string
+	for _, item := range items {
+		labels = append(labels, item.Label)
+	}
+	if got := strings.Join(labels, ","); got != "id,name,bio" {
+		t.Errorf("completion after a.: got %s", got)
+	}
+
+	var loc Location
+	c.call("textDocument/definition", TextDocumentPositionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Position:     Position{Line: 1, Character: 14},
+	}, &loc)
+	want := Location{
+		URI:   pathURI(filepath.Join(dir, "schema.sql")),
+		Range: Range{Start: Position{2, 2}, End: Position{2, 6}},
+	}
+	if loc != want {
+		t.Errorf("definition: got %+v, want %+v", loc, want)
+	}
+
+	var null any
+	c.call("shutdown", nil, &null)
+	c.send("exit", nil, false)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
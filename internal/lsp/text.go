@@ -0,0 +1,86 @@
+package lsp
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// LSP positions count UTF-16 code units, Go strings are indexed by byte.
+// These helpers convert between the two.
+
+// offset returns the byte offset of a position in src
+func offset(src string, pos Position) int {
+	off := 0
+	for line := 0; line < pos.Line; line++ {
+		i := strings.IndexByte(src[off:], '\n')
+		if i < 0 {
+			return len(src)
+		}
+		off += i + 1
+	}
+	for units := 0; off < len(src) && units < pos.Character; {
+		r, size := utf8.DecodeRuneInString(src[off:])
+		if r == '\n' {
+			break
+		}
+		units += utf16Len(r)
+		off += size
+	}
+	return off
+}
+
+// position returns the position of a byte offset in src
+func position(src string, off int) Position {
+	var pos Position
+	for i, r := range src {
+		if i >= off {
+			break
+		}
+		if r == '\n' {
+			pos.Line++
+			pos.Character = 0
+			continue
+		}
+		pos.Character += utf16Len(r)
+	}
+	return pos
+}
+
+// lineOffset returns the byte offset of a one-based line and column, counted
+// in runes, as reported by the compiler
+func lineOffset(src string, line, col int) int {
+	off := offset(src, Position{Line: line - 1})
+	for i := 1; i < col && off < len(src); i++ {
+		r, size := utf8.DecodeRuneInString(src[off:])
+		if r == '\n' {
+			break
+		}
+		off += size
+	}
+	return off
+}
+
+// word returns the bounds of the identifier around off. With qualified,
+// dots are part of the identifier, so `a.b` is returned as a whole.
+func word(src string, off int, qualified bool) (int, int) {
+	in := func(c byte) bool {
+		return c == '_' || c >= 0x80 ||
+			('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') ||
+			(qualified && c == '.')
+	}
+	start, end := off, off
+	for start > 0 && in(src[start-1]) {
+		start--
+	}
+	for end < len(src) && in(src[end]) {
+		end++
+	}
+	return start, end
+}
+
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
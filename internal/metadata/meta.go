@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/sqlc-dev/sqlc/internal/constants"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -23,15 +24,29 @@ type Metadata struct {
 	// If the map is empty, but the disable vet flag is specified, then all rules are ignored.
 	RuleSkiplist map[string]struct{}
 
+	// Budget limits the estimated cost of the query plan, see ParseBudget
+	Budget *Budget
+
 	Filename string
 }
 
+// A Budget is the most a query's plan may cost, as estimated by EXPLAIN. Zero
+// fields have no limit.
+type Budget struct {
+	Cost float64
+	Rows float64
+	// Invalid is why the budget couldn't be parsed. It's reported by vet, so
+	// a mistyped budget doesn't hold back code generation.
+	Invalid string
+}
+
 const (
 	CmdExec       = ":exec"
 	CmdExecResult = ":execresult"
 	CmdExecRows   = ":execrows"
 	CmdExecLastId = ":execlastid"
 	CmdMany       = ":many"
+	CmdIter       = ":iter"
 	CmdOne        = ":one"
 	CmdCopyFrom   = ":copyfrom"
 	CmdBatchExec  = ":batchexec"
@@ -98,7 +113,7 @@ func ParseQueryNameAndType(t string, commentStyle CommentSyntax) (string, string
 			part = part[:len(part)-1] // removes the trailing "*/" element
 		}
 		if len(part) == 3 {
-			return "", "", fmt.Errorf("missing query type [':one', ':many', ':exec', ':execrows', ':execlastid', ':execresult', ':copyfrom', 'batchexec', 'batchmany', 'batchone']: %s", line)
+			return "", "", fmt.Errorf("missing query type [':one', ':many', ':iter', ':exec', ':execrows', ':execlastid', ':execresult', ':copyfrom', 'batchexec', 'batchmany', 'batchone']: %s", line)
 		}
 		if len(part) != 4 {
 			return "", "", fmt.Errorf("invalid query comment: %s", line)
@@ -106,7 +121,7 @@ func ParseQueryNameAndType(t string, commentStyle CommentSyntax) (string, string
 		queryName := part[2]
 		queryType := strings.TrimSpace(part[3])
 		switch queryType {
-		case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows, CmdExecLastId, CmdCopyFrom, CmdBatchExec, CmdBatchMany, CmdBatchOne:
+		case CmdOne, CmdMany, CmdIter, CmdExec, CmdExecResult, CmdExecRows, CmdExecLastId, CmdCopyFrom, CmdBatchExec, CmdBatchMany, CmdBatchOne:
 		default:
 			return "", "", fmt.Errorf("invalid query type: %s", queryType)
 		}
@@ -170,3 +185,41 @@ func ParseCommentFlags(comments []string) (map[string]string, map[string]bool, m
 
 	return params, flags, ruleSkiplist, nil
 }
+
+// ParseBudget processes the @sqlc-budget comments of a query, e.g.
+// @sqlc-budget cost<1000 rows<10000. Limits can be split across lines. It
+// returns nil when the query has no budget.
+func ParseBudget(comments []string) (*Budget, error) {
+	var budget *Budget
+	for _, line := range comments {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != constants.QueryFlagSqlcBudget {
+			continue
+		}
+		if len(fields) == 1 {
+			return nil, fmt.Errorf("invalid budget: %s: missing limits", strings.TrimSpace(line))
+		}
+		if budget == nil {
+			budget = &Budget{}
+		}
+		for _, limit := range fields[1:] {
+			key, value, ok := strings.Cut(limit, "<")
+			if !ok {
+				return nil, fmt.Errorf("invalid budget limit %q: must be cost<N or rows<N", limit)
+			}
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid budget limit %q: %q isn't a positive number", limit, value)
+			}
+			switch key {
+			case "cost":
+				budget.Cost = n
+			case "rows":
+				budget.Rows = n
+			default:
+				return nil, fmt.Errorf("invalid budget limit %q: must be cost<N or rows<N", limit)
+			}
+		}
+	}
+	return budget, nil
+}
@@ -180,3 +180,45 @@ func TestParseQueryRuleSkiplist(t *testing.T) {
 		}
 	}
 }
+
+func TestParseBudget(t *testing.T) {
+	for _, tc := range []struct {
+		comments []string
+		budget   *Budget
+	}{
+		{
+			[]string{" name: GetFoos :many", " @param foo_id UUID"},
+			nil,
+		},
+		{
+			[]string{" name: GetFoos :many", " @sqlc-budget cost<1000 rows<10000"},
+			&Budget{Cost: 1000, Rows: 10000},
+		},
+		{
+			[]string{" name: GetFoos :many", " @sqlc-budget cost<12.5 ", " @sqlc-budget rows<100"},
+			&Budget{Cost: 12.5, Rows: 100},
+		},
+	} {
+		budget, err := ParseBudget(tc.comments)
+		if err != nil {
+			t.Errorf("expected comments to parse, got err: %s", err)
+			continue
+		}
+		if (budget == nil) != (tc.budget == nil) || (budget != nil && *budget != *tc.budget) {
+			t.Errorf("%q: expected budget %v, got %v", tc.comments, tc.budget, budget)
+		}
+	}
+
+	for _, line := range []string{
+		" @sqlc-budget",
+		" @sqlc-budget cost",
+		" @sqlc-budget cost>10",
+		" @sqlc-budget cost<ten",
+		" @sqlc-budget rows<0",
+		" @sqlc-budget time<10",
+	} {
+		if _, err := ParseBudget([]string{line}); err == nil {
+			t.Errorf("expected invalid budget: %q", line)
+		}
+	}
+}
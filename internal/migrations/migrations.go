@@ -2,37 +2,87 @@ package migrations
 
 import (
 	"bufio"
+	"regexp"
 	"strings"
 )
 
-// Remove all lines after a rollback comment.
+type section int
+
+const (
+	sectionNone section = iota
+	sectionUp
+	sectionDown
+)
+
+// Return the section that a line starts, if any.
 //
-// goose:       -- +goose Down
-// sql-migrate: -- +migrate Down
+// goose:       -- +goose Up / -- +goose Down
+// sql-migrate: -- +migrate Up / -- +migrate Down
 // tern:        ---- create above / drop below ----
-// dbmate:      -- migrate:down
+// dbmate:      -- migrate:up / -- migrate:down
+func marker(line string) section {
+	switch {
+	case strings.HasPrefix(line, "-- +goose Up"),
+		strings.HasPrefix(line, "-- +migrate Up"),
+		strings.HasPrefix(line, "-- migrate:up"):
+		return sectionUp
+	case strings.HasPrefix(line, "-- +goose Down"),
+		strings.HasPrefix(line, "-- +migrate Down"),
+		strings.HasPrefix(line, "---- create above / drop below ----"),
+		strings.HasPrefix(line, "-- migrate:down"):
+		return sectionDown
+	}
+	return sectionNone
+}
+
+// Remove all rollback statements.
+//
+// Down sections end at the next up marker, so files that list their down
+// migration first are handled too. Lines inside a down section that is
+// followed by an up section are replaced by empty lines to keep the line
+// numbers of the remaining statements intact. Markers inside goose
+// StatementBegin / StatementEnd blocks are part of the statement and are
+// ignored.
 func RemoveRollbackStatements(contents string) string {
 	s := bufio.NewScanner(strings.NewReader(contents))
 	var lines []string
+	var skipped int
+	current := sectionNone
+	inStatement := false
 	for s.Scan() {
-		if strings.HasPrefix(s.Text(), "-- +goose Down") {
-			break
-		}
-		if strings.HasPrefix(s.Text(), "-- +migrate Down") {
-			break
-		}
-		if strings.HasPrefix(s.Text(), "---- create above / drop below ----") {
-			break
+		line := s.Text()
+		switch {
+		case strings.HasPrefix(line, "-- +goose StatementBegin"):
+			inStatement = true
+		case strings.HasPrefix(line, "-- +goose StatementEnd"):
+			inStatement = false
+		case !inStatement:
+			if m := marker(line); m != sectionNone {
+				if current == sectionDown && m == sectionUp {
+					for range skipped {
+						lines = append(lines, "")
+					}
+					skipped = 0
+				}
+				current = m
+			}
 		}
-		if strings.HasPrefix(s.Text(), "-- migrate:down") {
-			break
+		if current == sectionDown {
+			skipped += 1
+			continue
 		}
-		lines = append(lines, s.Text())
+		lines = append(lines, line)
 	}
 	return strings.Join(lines, "\n")
 }
 
+var flywayUndo = regexp.MustCompile(`^U[0-9][0-9._]*__.*\.sql$`)
+
 func IsDown(filename string) bool {
 	// Remove golang-migrate rollback files.
-	return strings.HasSuffix(filename, ".down.sql")
+	if strings.HasSuffix(filename, ".down.sql") {
+		return true
+	}
+	// Remove flyway undo migrations.
+	return flywayUndo.MatchString(filename)
 }
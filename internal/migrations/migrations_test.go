@@ -56,6 +56,36 @@ const outputDbmate = `
 -- migrate:up
 CREATE TABLE foo (bar int);`
 
+const inputDownFirst = `-- migrate:down
+DROP TABLE foo;
+-- migrate:up
+CREATE TABLE foo (bar int);`
+
+const outputDownFirst = `
+
+-- migrate:up
+CREATE TABLE foo (bar int);`
+
+const inputGooseStatement = `-- +goose Up
+-- +goose StatementBegin
+CREATE FUNCTION f() RETURNS text AS $$
+-- +goose Down
+SELECT 'up';
+$$ LANGUAGE sql;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP FUNCTION f;`
+
+const outputGooseStatement = `-- +goose Up
+-- +goose StatementBegin
+CREATE FUNCTION f() RETURNS text AS $$
+-- +goose Down
+SELECT 'up';
+$$ LANGUAGE sql;
+-- +goose StatementEnd
+`
+
 func TestRemoveRollback(t *testing.T) {
 	if diff := cmp.Diff(outputGoose, RemoveRollbackStatements(inputGoose)); diff != "" {
 		t.Errorf("goose migration mismatch:\n%s", diff)
@@ -69,6 +99,12 @@ func TestRemoveRollback(t *testing.T) {
 	if diff := cmp.Diff(outputDbmate, RemoveRollbackStatements(inputDbmate)); diff != "" {
 		t.Errorf("dbmate migration mismatch:\n%s", diff)
 	}
+	if diff := cmp.Diff(outputDownFirst, RemoveRollbackStatements(inputDownFirst)); diff != "" {
+		t.Errorf("down-first migration mismatch:\n%s", diff)
+	}
+	if diff := cmp.Diff(outputGooseStatement, RemoveRollbackStatements(inputGooseStatement)); diff != "" {
+		t.Errorf("goose statement migration mismatch:\n%s", diff)
+	}
 }
 
 func TestRemoveGolangMigrateRollback(t *testing.T) {
@@ -79,6 +115,10 @@ func TestRemoveGolangMigrateRollback(t *testing.T) {
 		"migrations/2.sql":      false,
 		"migrations/foo.sql":    false,
 		"migrations/1.down.sql": true,
+		// flyway undo migrations
+		"U1__init.sql":   true,
+		"V1__init.sql":   false,
+		"U1.2__init.sql": true,
 	}
 
 	for filename, want := range filenames {
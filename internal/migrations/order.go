@@ -0,0 +1,243 @@
+package migrations
+
+import (
+	"bufio"
+	"cmp"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Tool is the migration tool that manages a directory of schema files.
+type Tool string
+
+const (
+	ToolUnknown       Tool = ""
+	ToolAtlas         Tool = "atlas"
+	ToolDbmate        Tool = "dbmate"
+	ToolFlyway        Tool = "flyway"
+	ToolGolangMigrate Tool = "golang-migrate"
+	ToolGoose         Tool = "goose"
+	ToolSQLMigrate    Tool = "sql-migrate"
+	ToolTern          Tool = "tern"
+)
+
+var (
+	flywayVersioned  = regexp.MustCompile(`^V([0-9][0-9._]*)__.*\.sql$`)
+	flywayRepeatable = regexp.MustCompile(`^R__(.*)\.sql$`)
+	golangMigrateUp  = regexp.MustCompile(`^([0-9]+)_.*\.up\.sql$`)
+	numericPrefix    = regexp.MustCompile(`^([0-9]+)`)
+)
+
+// Detect returns the migration tool used for files, which must all live in
+// dir. Tools with distinct filename conventions are detected from the names
+// alone; the others are detected from the up / down markers in the first
+// file that contains one.
+func Detect(dir string, files []string) (Tool, error) {
+	if _, err := os.Stat(filepath.Join(dir, "atlas.sum")); err == nil {
+		return ToolAtlas, nil
+	}
+	for _, file := range files {
+		base := filepath.Base(file)
+		if flywayVersioned.MatchString(base) || flywayRepeatable.MatchString(base) {
+			return ToolFlyway, nil
+		}
+		if golangMigrateUp.MatchString(base) {
+			return ToolGolangMigrate, nil
+		}
+	}
+	for _, file := range files {
+		tool, err := detectFromContents(file)
+		if err != nil {
+			return ToolUnknown, err
+		}
+		if tool != ToolUnknown {
+			return tool, nil
+		}
+	}
+	return ToolUnknown, nil
+}
+
+func detectFromContents(file string) (Tool, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return ToolUnknown, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, 1024*1024)
+	for s.Scan() {
+		line := s.Text()
+		switch {
+		case strings.HasPrefix(line, "-- +goose "):
+			return ToolGoose, nil
+		case strings.HasPrefix(line, "-- +migrate "):
+			return ToolSQLMigrate, nil
+		case strings.HasPrefix(line, "-- migrate:"):
+			return ToolDbmate, nil
+		case strings.HasPrefix(line, "---- create above / drop below ----"):
+			return ToolTern, nil
+		}
+	}
+	return ToolUnknown, s.Err()
+}
+
+// A migration version. Most tools use a single number, either a sequence or a
+// timestamp, while flyway allows dotted versions like 1.2.10.
+type version []uint64
+
+func parseVersion(s string) (version, bool) {
+	var v version
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == '.' || r == '_' }) {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		v = append(v, n)
+	}
+	return v, len(v) > 0
+}
+
+func compareVersions(a, b version) int {
+	for i := range min(len(a), len(b)) {
+		if c := cmp.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+type entry struct {
+	path string
+	// Files without a version are applied after all versioned files
+	versioned bool
+	version   version
+	// Secondary sort key, e.g. flyway repeatable migrations sort by description
+	key string
+}
+
+func newEntry(tool Tool, path string) entry {
+	base := filepath.Base(path)
+	e := entry{path: path, key: base}
+	switch tool {
+	case ToolFlyway:
+		if m := flywayVersioned.FindStringSubmatch(base); m != nil {
+			e.version, e.versioned = parseVersion(strings.TrimRight(m[1], "._"))
+		} else if m := flywayRepeatable.FindStringSubmatch(base); m != nil {
+			e.key = m[1]
+		}
+	case ToolGolangMigrate, ToolGoose, ToolSQLMigrate, ToolDbmate, ToolTern:
+		// goose accepts both sequential (00001_init.sql) and timestamp
+		// (20230102150405_init.sql) versions, which sort the same way
+		// numerically.
+		if m := numericPrefix.FindString(base); m != "" {
+			e.version, e.versioned = parseVersion(m)
+		}
+	}
+	return e
+}
+
+// atlas applies files in the order they're listed in atlas.sum
+func atlasOrder(dir string) (map[string]int, error) {
+	f, err := os.Open(filepath.Join(dir, "atlas.sum"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	order := map[string]int{}
+	s := bufio.NewScanner(f)
+	first := true
+	for s.Scan() {
+		// The first line is the checksum of the whole directory
+		if first {
+			first = false
+			continue
+		}
+		name, _, _ := strings.Cut(s.Text(), " ")
+		if name != "" {
+			order[name] = len(order)
+		}
+	}
+	return order, s.Err()
+}
+
+func sortDir(dir string, files []string) error {
+	tool, err := Detect(dir, files)
+	if err != nil {
+		return err
+	}
+	switch tool {
+	case ToolUnknown:
+		return nil
+	case ToolAtlas:
+		order, err := atlasOrder(dir)
+		if err != nil {
+			return err
+		}
+		slices.SortStableFunc(files, func(a, b string) int {
+			ai, aok := order[filepath.Base(a)]
+			bi, bok := order[filepath.Base(b)]
+			switch {
+			case aok && bok:
+				return cmp.Compare(ai, bi)
+			case aok:
+				return -1
+			case bok:
+				return 1
+			}
+			return 0
+		})
+		return nil
+	}
+	entries := make([]entry, len(files))
+	for i, f := range files {
+		entries[i] = newEntry(tool, f)
+	}
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		switch {
+		case a.versioned && b.versioned:
+			return compareVersions(a.version, b.version)
+		case a.versioned:
+			return -1
+		case b.versioned:
+			return 1
+		}
+		return strings.Compare(a.key, b.key)
+	})
+	for i := range entries {
+		files[i] = entries[i].path
+	}
+	return nil
+}
+
+// Order sorts schema files so that the files in each migration directory are
+// applied in the order the migration tool would apply them, instead of the
+// order the filesystem lists them in. Files are only reordered among the
+// positions occupied by their own directory, and files in directories without
+// a detected tool aren't reordered.
+func Order(files []string) ([]string, error) {
+	var dirs []string
+	byDir := map[string][]string{}
+	for _, file := range files {
+		dir := filepath.Dir(file)
+		if _, ok := byDir[dir]; !ok {
+			dirs = append(dirs, dir)
+		}
+		byDir[dir] = append(byDir[dir], file)
+	}
+	for _, dir := range dirs {
+		if err := sortDir(dir, byDir[dir]); err != nil {
+			return nil, err
+		}
+	}
+	ordered := make([]string, 0, len(files))
+	for _, file := range files {
+		dir := filepath.Dir(file)
+		ordered = append(ordered, byDir[dir][0])
+		byDir[dir] = byDir[dir][1:]
+	}
+	return ordered, nil
+}
@@ -0,0 +1,128 @@
+package migrations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, contents := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		files map[string]string
+		input []string
+		want  []string
+	}{
+		{
+			name: "golang-migrate",
+			files: map[string]string{
+				"1_init.up.sql":   "",
+				"2_users.up.sql":  "",
+				"10_posts.up.sql": "",
+			},
+			input: []string{"10_posts.up.sql", "1_init.up.sql", "2_users.up.sql"},
+			want:  []string{"1_init.up.sql", "2_users.up.sql", "10_posts.up.sql"},
+		},
+		{
+			name: "goose",
+			files: map[string]string{
+				"9_init.sql":               "-- +goose Up\n",
+				"00010_users.sql":          "-- +goose Up\n",
+				"20230102150405_posts.sql": "-- +goose Up\n",
+			},
+			input: []string{"00010_users.sql", "20230102150405_posts.sql", "9_init.sql"},
+			want:  []string{"9_init.sql", "00010_users.sql", "20230102150405_posts.sql"},
+		},
+		{
+			name: "flyway",
+			files: map[string]string{
+				"R__views.sql":     "",
+				"V1__init.sql":     "",
+				"V1.10__posts.sql": "",
+				"V1.2__users.sql":  "",
+				"R__functions.sql": "",
+			},
+			input: []string{"R__views.sql", "R__functions.sql", "V1.10__posts.sql", "V1.2__users.sql", "V1__init.sql"},
+			want:  []string{"V1__init.sql", "V1.2__users.sql", "V1.10__posts.sql", "R__functions.sql", "R__views.sql"},
+		},
+		{
+			name: "atlas",
+			files: map[string]string{
+				"atlas.sum":   "h1:sum=\nb_users.sql h1:b=\na_posts.sql h1:a=\n",
+				"a_posts.sql": "",
+				"b_users.sql": "",
+			},
+			input: []string{"a_posts.sql", "b_users.sql"},
+			want:  []string{"b_users.sql", "a_posts.sql"},
+		},
+		{
+			name: "unknown",
+			files: map[string]string{
+				"schema.sql": "",
+				"10.sql":     "",
+				"2.sql":      "",
+			},
+			input: []string{"schema.sql", "10.sql", "2.sql"},
+			want:  []string{"schema.sql", "10.sql", "2.sql"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, tc.files)
+			var input, want []string
+			for _, f := range tc.input {
+				input = append(input, filepath.Join(dir, f))
+			}
+			for _, f := range tc.want {
+				want = append(want, filepath.Join(dir, f))
+			}
+			got, err := Order(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("order mismatch:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOrderKeepsDirectoryPositions(t *testing.T) {
+	root := t.TempDir()
+	a := filepath.Join(root, "a")
+	b := filepath.Join(root, "b")
+	writeFiles(t, a, map[string]string{"1_x.up.sql": "", "10_x.up.sql": ""})
+	writeFiles(t, b, map[string]string{"schema.sql": ""})
+
+	input := []string{
+		filepath.Join(a, "10_x.up.sql"),
+		filepath.Join(b, "schema.sql"),
+		filepath.Join(a, "1_x.up.sql"),
+	}
+	want := []string{
+		filepath.Join(a, "1_x.up.sql"),
+		filepath.Join(b, "schema.sql"),
+		filepath.Join(a, "10_x.up.sql"),
+	}
+	got, err := Order(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("order mismatch:\n%s", diff)
+	}
+}
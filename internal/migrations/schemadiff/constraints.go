@@ -0,0 +1,201 @@
+package schemadiff
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+// indexKey identifies an index across schemas. Indexes without a name, like
+// the constraints of SQLite tables, are identified by their columns.
+func indexKey(idx *catalog.Index) string {
+	if idx.Name != "" {
+		return idx.Name
+	}
+	kind := "index"
+	switch {
+	case idx.Primary:
+		kind = "primary"
+	case idx.Unique:
+		kind = "unique"
+	}
+	return kind + "(" + strings.Join(idx.Columns, ",") + ")"
+}
+
+func findIndex(t *catalog.Table, key string) *catalog.Index {
+	for _, idx := range t.Indexes {
+		if indexKey(idx) == key {
+			return idx
+		}
+	}
+	return nil
+}
+
+func sameIndex(a, b *catalog.Index) bool {
+	return a.Unique == b.Unique && a.Primary == b.Primary && a.Constraint == b.Constraint && slices.Equal(a.Columns, b.Columns)
+}
+
+// foreignKeyKey identifies a foreign key across schemas, by name if it has
+// one and by its columns otherwise.
+func foreignKeyKey(fk *catalog.ForeignKey) string {
+	if fk.Name != "" {
+		return fk.Name
+	}
+	return "(" + strings.Join(fk.Columns, ",") + ")"
+}
+
+func findForeignKey(t *catalog.Table, key string) *catalog.ForeignKey {
+	for _, fk := range t.ForeignKeys {
+		if foreignKeyKey(fk) == key {
+			return fk
+		}
+	}
+	return nil
+}
+
+func sameForeignKey(a, b *catalog.ForeignKey) bool {
+	return a.RefTable.Schema == b.RefTable.Schema && a.RefTable.Name == b.RefTable.Name &&
+		slices.Equal(a.Columns, b.Columns) && slices.Equal(a.RefColumns, b.RefColumns)
+}
+
+// constraintName returns the CONSTRAINT clause naming a constraint, if it
+// has a name that can be given. MySQL always names the primary key PRIMARY.
+func (d *differ) constraintName(name string, primary bool) string {
+	if name == "" || (primary && d.engine == config.EngineMySQL) {
+		return ""
+	}
+	return "CONSTRAINT " + name + " "
+}
+
+// indexConstraint returns the table constraint of a primary key or unique
+// index
+func (d *differ) indexConstraint(idx *catalog.Index) string {
+	kind := "UNIQUE"
+	if idx.Primary {
+		kind = "PRIMARY KEY"
+	}
+	return d.constraintName(idx.Name, idx.Primary) + kind + " (" + strings.Join(idx.Columns, ", ") + ")"
+}
+
+func (d *differ) references(fk *catalog.ForeignKey) string {
+	ref := "REFERENCES " + d.relName(fk.RefTable.Schema, fk.RefTable.Name)
+	if len(fk.RefColumns) > 0 {
+		ref += " (" + strings.Join(fk.RefColumns, ", ") + ")"
+	}
+	return ref
+}
+
+func (d *differ) foreignKeyConstraint(fk *catalog.ForeignKey) string {
+	return d.constraintName(fk.Name, false) + "FOREIGN KEY (" + strings.Join(fk.Columns, ", ") + ") " + d.references(fk)
+}
+
+// createIndex adds the CREATE INDEX statement of an index on table name.
+// Indexes on expressions aren't tracked well enough to be recreated.
+func (d *differ) createIndex(name string, idx *catalog.Index) {
+	if idx.Name == "" || slices.Contains(idx.Columns, "") {
+		d.note("%s: an index on (%s) must be created by hand", name, strings.Join(idx.Columns, ", "))
+		return
+	}
+	unique := ""
+	if idx.Unique {
+		unique = "UNIQUE "
+	}
+	d.add("CREATE %sINDEX %s ON %s (%s)", unique, idx.Name, name, strings.Join(idx.Columns, ", "))
+}
+
+func (d *differ) dropIndex(schema, name string, idx *catalog.Index) {
+	switch {
+	case idx.Constraint && d.engine == config.EngineSQLite:
+		d.note("%s: SQLite can't drop the constraint %s without rebuilding the table", name, d.indexConstraint(idx))
+	case idx.Constraint && d.engine == config.EngineMySQL && idx.Primary:
+		d.add("ALTER TABLE %s DROP PRIMARY KEY", name)
+	case idx.Constraint && d.engine == config.EngineMySQL:
+		d.add("ALTER TABLE %s DROP INDEX %s", name, idx.Name)
+	case idx.Name == "":
+		d.note("%s: an unnamed index on (%s) must be dropped by hand", name, strings.Join(idx.Columns, ", "))
+	case idx.Constraint:
+		d.add("ALTER TABLE %s DROP CONSTRAINT %s", name, idx.Name)
+	case d.engine == config.EngineMySQL:
+		d.add("DROP INDEX %s ON %s", idx.Name, name)
+	default:
+		d.add("DROP INDEX %s", d.relName(schema, idx.Name))
+	}
+}
+
+func (d *differ) addIndex(name string, idx *catalog.Index) {
+	switch {
+	case !idx.Constraint:
+		d.createIndex(name, idx)
+	case d.engine == config.EngineSQLite:
+		d.note("%s: SQLite can't add the constraint %s without rebuilding the table", name, d.indexConstraint(idx))
+	default:
+		d.add("ALTER TABLE %s ADD %s", name, d.indexConstraint(idx))
+	}
+}
+
+func (d *differ) dropForeignKey(name string, fk *catalog.ForeignKey) {
+	switch {
+	case d.engine == config.EngineSQLite:
+		d.note("%s: SQLite can't drop the constraint %s without rebuilding the table", name, d.foreignKeyConstraint(fk))
+	case fk.Name == "":
+		d.note("%s: an unnamed foreign key on (%s) must be dropped by hand", name, strings.Join(fk.Columns, ", "))
+	case d.engine == config.EngineMySQL:
+		d.add("ALTER TABLE %s DROP FOREIGN KEY %s", name, fk.Name)
+	default:
+		d.add("ALTER TABLE %s DROP CONSTRAINT %s", name, fk.Name)
+	}
+}
+
+func (d *differ) addForeignKey(name string, fk *catalog.ForeignKey) {
+	if d.engine == config.EngineSQLite {
+		d.note("%s: SQLite can't add the constraint %s without rebuilding the table", name, d.foreignKeyConstraint(fk))
+		return
+	}
+	d.add("ALTER TABLE %s ADD %s", name, d.foreignKeyConstraint(fk))
+}
+
+// dropConstraints drops the indexes and foreign keys of prev that next
+// doesn't have, or that changed.
+func (d *differ) dropConstraints(schema, name string, prev, next *catalog.Table) {
+	for _, fk := range prev.ForeignKeys {
+		if cur := findForeignKey(next, foreignKeyKey(fk)); cur == nil || !sameForeignKey(fk, cur) {
+			d.dropForeignKey(name, fk)
+		}
+	}
+	for _, idx := range prev.Indexes {
+		if cur := findIndex(next, indexKey(idx)); cur == nil || !sameIndex(idx, cur) {
+			d.dropIndex(schema, name, idx)
+		}
+	}
+}
+
+// addConstraints adds the indexes and foreign keys of next that prev doesn't
+// have, or that changed. Foreign keys in skip were already added inline.
+func (d *differ) addConstraints(schema, name string, prev, next *catalog.Table, skip []*catalog.ForeignKey) {
+	for _, idx := range next.Indexes {
+		if old := findIndex(prev, indexKey(idx)); old == nil || !sameIndex(idx, old) {
+			d.addIndex(name, idx)
+		}
+	}
+	for _, fk := range next.ForeignKeys {
+		if slices.Contains(skip, fk) {
+			continue
+		}
+		if old := findForeignKey(prev, foreignKeyKey(fk)); old == nil || !sameForeignKey(fk, old) {
+			d.addForeignKey(name, fk)
+		}
+	}
+}
+
+// inlineForeignKey returns the new foreign key on just col, which SQLite
+// can only add as part of the column definition.
+func inlineForeignKey(prev, next *catalog.Table, col string) *catalog.ForeignKey {
+	for _, fk := range next.ForeignKeys {
+		if len(fk.Columns) == 1 && fk.Columns[0] == col && findForeignKey(prev, foreignKeyKey(fk)) == nil {
+			return fk
+		}
+	}
+	return nil
+}
@@ -0,0 +1,442 @@
+package schemadiff
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+)
+
+// Diff returns the statements that migrate a database from one schema to
+// another. Changes the engine can't make in place are returned as SQL
+// comments, so that they show up in the migration for a human to resolve.
+//
+// Statements are ordered so that dependencies exist before they're used:
+// views and functions are dropped first and created last, and tables are
+// created after the schemas and types they use.
+func Diff(engine config.Engine, from, to *Schema) []string {
+	d := &differ{engine: engine, from: from, to: to}
+	d.dropViews()
+	d.dropFuncs()
+	d.createSchemas()
+	d.createEnums()
+	d.createTables()
+	d.alterTables()
+	d.dropTables()
+	d.dropEnums()
+	d.dropSchemas()
+	d.createFuncs()
+	d.createViews()
+	return d.stmts
+}
+
+type differ struct {
+	engine config.Engine
+	from   *Schema
+	to     *Schema
+	stmts  []string
+}
+
+func (d *differ) add(format string, args ...any) {
+	d.stmts = append(d.stmts, fmt.Sprintf(format, args...))
+}
+
+func (d *differ) note(format string, args ...any) {
+	d.stmts = append(d.stmts, "-- "+fmt.Sprintf(format, args...))
+}
+
+// Schemas and enums are only diffed for PostgreSQL. MySQL enums are part of
+// the column type, and neither MySQL nor SQLite has schemas.
+func (d *differ) postgres() bool {
+	return d.engine == config.EnginePostgreSQL
+}
+
+func (d *differ) relName(schema, name string) string {
+	if schema == "" || schema == d.to.Catalog.DefaultSchema {
+		return name
+	}
+	return schema + "." + name
+}
+
+// displayKey strips the default schema from a Views or Funcs key
+func (d *differ) displayKey(key string) string {
+	return strings.TrimPrefix(key, d.to.Catalog.DefaultSchema+".")
+}
+
+func normalize(stmt string) string {
+	return strings.Join(strings.Fields(stmt), " ")
+}
+
+func findSchema(c *catalog.Catalog, name string) *catalog.Schema {
+	for _, s := range c.Schemas {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+func findTable(s *catalog.Schema, name string) *catalog.Table {
+	if s == nil {
+		return nil
+	}
+	for _, t := range s.Tables {
+		if t.Rel.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func findColumn(t *catalog.Table, name string) *catalog.Column {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func findEnum(s *catalog.Schema, name string) *catalog.Enum {
+	if s == nil {
+		return nil
+	}
+	for _, typ := range s.Types {
+		if e, ok := typ.(*catalog.Enum); ok && e.Name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// tables returns the tables in a schema, leaving out views
+func tables(s *Schema, schema *catalog.Schema) []*catalog.Table {
+	var tbls []*catalog.Table
+	for _, t := range schema.Tables {
+		if _, ok := s.Views[schema.Name+"."+t.Rel.Name]; ok {
+			continue
+		}
+		tbls = append(tbls, t)
+	}
+	return tbls
+}
+
+// views returns the keys of the views in s, in the order they were created
+func views(s *Schema) []string {
+	var keys []string
+	for _, schema := range s.Catalog.Schemas {
+		for _, t := range schema.Tables {
+			key := schema.Name + "." + t.Rel.Name
+			if _, ok := s.Views[key]; ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func typeName(defaultSchema string, t *ast.TypeName) string {
+	// The pg_catalog schema is always on the search path
+	if t.Schema == "" || t.Schema == "pg_catalog" || t.Schema == defaultSchema {
+		return t.Name
+	}
+	return t.Schema + "." + t.Name
+}
+
+func (d *differ) columnType(s *Schema, col *catalog.Column) string {
+	var b strings.Builder
+	e := findEnum(findSchema(s.Catalog, s.Catalog.DefaultSchema), col.Type.Name)
+	if d.engine == config.EngineMySQL && col.Type.Schema == "" && e != nil {
+		vals := make([]string, len(e.Vals))
+		for i, v := range e.Vals {
+			vals[i] = quote(v)
+		}
+		b.WriteString("enum(" + strings.Join(vals, ",") + ")")
+	} else {
+		b.WriteString(typeName(s.Catalog.DefaultSchema, &col.Type))
+		if col.Length != nil {
+			b.WriteString("(" + strconv.Itoa(*col.Length) + ")")
+		}
+	}
+	if col.IsUnsigned {
+		b.WriteString(" unsigned")
+	}
+	if col.IsArray {
+		b.WriteString(strings.Repeat("[]", max(col.ArrayDims, 1)))
+	}
+	return b.String()
+}
+
+// columnDef returns the definition of a column. Primary keys, unique and
+// foreign key constraints are added as table constraints.
+func (d *differ) columnDef(s *Schema, col *catalog.Column) string {
+	def := col.Name + " " + d.columnType(s, col)
+	if col.IsNotNull {
+		def += " NOT NULL"
+	}
+	if col.Default != "" {
+		def += " DEFAULT " + col.Default
+	}
+	return def
+}
+
+func (d *differ) dropViews() {
+	keys := views(d.from)
+	slices.Reverse(keys)
+	for _, key := range keys {
+		def, ok := d.to.Views[key]
+		if ok && normalize(def) == normalize(d.from.Views[key]) {
+			continue
+		}
+		d.add("DROP VIEW %s", d.displayKey(key))
+	}
+}
+
+func (d *differ) createViews() {
+	for _, key := range views(d.to) {
+		def, ok := d.from.Views[key]
+		if ok && normalize(def) == normalize(d.to.Views[key]) {
+			continue
+		}
+		d.add("%s", d.to.Views[key])
+	}
+}
+
+func (d *differ) dropFuncs() {
+	for _, key := range sortedKeys(d.from.Funcs) {
+		def, ok := d.to.Funcs[key]
+		if ok && normalize(def) == normalize(d.from.Funcs[key]) {
+			continue
+		}
+		name := d.displayKey(key)
+		if d.engine == config.EngineMySQL {
+			// MySQL doesn't overload functions, so they're dropped by name
+			name, _, _ = strings.Cut(name, "(")
+		}
+		d.add("DROP FUNCTION %s", name)
+	}
+}
+
+func (d *differ) createFuncs() {
+	for _, key := range sortedKeys(d.to.Funcs) {
+		def, ok := d.from.Funcs[key]
+		if ok && normalize(def) == normalize(d.to.Funcs[key]) {
+			continue
+		}
+		d.add("%s", d.to.Funcs[key])
+	}
+}
+
+func (d *differ) createSchemas() {
+	if !d.postgres() {
+		return
+	}
+	for _, schema := range d.to.Catalog.Schemas {
+		if findSchema(d.from.Catalog, schema.Name) == nil {
+			d.add("CREATE SCHEMA %s", schema.Name)
+		}
+	}
+}
+
+func (d *differ) dropSchemas() {
+	if !d.postgres() {
+		return
+	}
+	for _, schema := range d.from.Catalog.Schemas {
+		if findSchema(d.to.Catalog, schema.Name) == nil {
+			d.add("DROP SCHEMA %s", schema.Name)
+		}
+	}
+}
+
+func (d *differ) createEnums() {
+	if !d.postgres() {
+		return
+	}
+	for _, schema := range d.to.Catalog.Schemas {
+		for _, typ := range schema.Types {
+			e, ok := typ.(*catalog.Enum)
+			if !ok {
+				continue
+			}
+			name := d.relName(schema.Name, e.Name)
+			prev := findEnum(findSchema(d.from.Catalog, schema.Name), e.Name)
+			if prev == nil {
+				vals := make([]string, len(e.Vals))
+				for i, v := range e.Vals {
+					vals[i] = quote(v)
+				}
+				d.add("CREATE TYPE %s AS ENUM (%s)", name, strings.Join(vals, ", "))
+				continue
+			}
+			for i, v := range e.Vals {
+				if slices.Contains(prev.Vals, v) {
+					continue
+				}
+				// Values before this one either already existed or were
+				// added by a previous statement.
+				switch {
+				case i > 0:
+					d.add("ALTER TYPE %s ADD VALUE %s AFTER %s", name, quote(v), quote(e.Vals[i-1]))
+				case len(prev.Vals) > 0:
+					d.add("ALTER TYPE %s ADD VALUE %s BEFORE %s", name, quote(v), quote(prev.Vals[0]))
+				default:
+					d.add("ALTER TYPE %s ADD VALUE %s", name, quote(v))
+				}
+			}
+			for _, v := range prev.Vals {
+				if !slices.Contains(e.Vals, v) {
+					d.note("%s: value %s was removed, but PostgreSQL can't drop enum values", name, quote(v))
+				}
+			}
+		}
+	}
+}
+
+func (d *differ) dropEnums() {
+	if !d.postgres() {
+		return
+	}
+	for _, schema := range d.from.Catalog.Schemas {
+		for _, typ := range schema.Types {
+			e, ok := typ.(*catalog.Enum)
+			if !ok {
+				continue
+			}
+			if findEnum(findSchema(d.to.Catalog, schema.Name), e.Name) == nil {
+				d.add("DROP TYPE %s", d.relName(schema.Name, e.Name))
+			}
+		}
+	}
+}
+
+func (d *differ) createTables() {
+	for _, schema := range d.to.Catalog.Schemas {
+		prev := findSchema(d.from.Catalog, schema.Name)
+		for _, t := range tables(d.to, schema) {
+			if findTable(prev, t.Rel.Name) != nil {
+				continue
+			}
+			name := d.relName(schema.Name, t.Rel.Name)
+			var defs []string
+			for _, col := range t.Columns {
+				if col.IsHidden {
+					continue
+				}
+				defs = append(defs, "  "+d.columnDef(d.to, col))
+			}
+			for _, idx := range t.Indexes {
+				if idx.Constraint {
+					defs = append(defs, "  "+d.indexConstraint(idx))
+				}
+			}
+			for _, fk := range t.ForeignKeys {
+				defs = append(defs, "  "+d.foreignKeyConstraint(fk))
+			}
+			d.add("CREATE TABLE %s (\n%s\n)", name, strings.Join(defs, ",\n"))
+			for _, idx := range t.Indexes {
+				if !idx.Constraint {
+					d.createIndex(name, idx)
+				}
+			}
+		}
+	}
+}
+
+func (d *differ) dropTables() {
+	for _, schema := range d.from.Catalog.Schemas {
+		next := findSchema(d.to.Catalog, schema.Name)
+		for _, t := range tables(d.from, schema) {
+			if findTable(next, t.Rel.Name) == nil {
+				d.add("DROP TABLE %s", d.relName(schema.Name, t.Rel.Name))
+			}
+		}
+	}
+}
+
+func (d *differ) alterTables() {
+	for _, schema := range d.to.Catalog.Schemas {
+		prevSchema := findSchema(d.from.Catalog, schema.Name)
+		for _, t := range tables(d.to, schema) {
+			prev := findTable(prevSchema, t.Rel.Name)
+			if prev == nil {
+				continue
+			}
+			d.alterTable(schema.Name, d.relName(schema.Name, t.Rel.Name), prev, t)
+		}
+	}
+}
+
+func (d *differ) alterTable(schema, name string, prev, next *catalog.Table) {
+	d.dropConstraints(schema, name, prev, next)
+	var inline []*catalog.ForeignKey
+	for _, col := range next.Columns {
+		// Hidden columns, like the rowid of SQLite tables, are implicit
+		if col.IsHidden {
+			continue
+		}
+		old := findColumn(prev, col.Name)
+		if old == nil {
+			def := d.columnDef(d.to, col)
+			if d.engine == config.EngineSQLite {
+				if fk := inlineForeignKey(prev, next, col.Name); fk != nil {
+					def += " " + d.references(fk)
+					inline = append(inline, fk)
+				}
+			}
+			d.add("ALTER TABLE %s ADD COLUMN %s", name, def)
+			continue
+		}
+		oldType, newType := d.columnType(d.from, old), d.columnType(d.to, col)
+		if oldType == newType && old.IsNotNull == col.IsNotNull && old.Default == col.Default {
+			continue
+		}
+		switch d.engine {
+		case config.EnginePostgreSQL:
+			if oldType != newType {
+				d.add("ALTER TABLE %s ALTER COLUMN %s TYPE %s", name, col.Name, newType)
+			}
+			if old.IsNotNull != col.IsNotNull {
+				if col.IsNotNull {
+					d.add("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL", name, col.Name)
+				} else {
+					d.add("ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL", name, col.Name)
+				}
+			}
+			if old.Default != col.Default {
+				if col.Default != "" {
+					d.add("ALTER TABLE %s ALTER COLUMN %s SET DEFAULT %s", name, col.Name, col.Default)
+				} else {
+					d.add("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT", name, col.Name)
+				}
+			}
+		case config.EngineMySQL:
+			d.add("ALTER TABLE %s MODIFY COLUMN %s", name, d.columnDef(d.to, col))
+		default:
+			d.note("%s.%s: SQLite can't change the type, nullability or default of a column without rebuilding the table", name, col.Name)
+		}
+	}
+	for _, col := range prev.Columns {
+		if !col.IsHidden && findColumn(next, col.Name) == nil {
+			d.add("ALTER TABLE %s DROP COLUMN %s", name, col.Name)
+		}
+	}
+	d.addConstraints(schema, name, prev, next, inline)
+}
@@ -0,0 +1,169 @@
+package schemadiff
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+func load(t *testing.T, engine config.Engine, schema string) *Schema {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "schema.sql")
+	if err := os.WriteFile(path, []byte(schema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	conf := config.SQL{Engine: engine}
+	s, err := Load(context.Background(), conf, config.Combine(config.Config{}, conf), []string{path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestDiff(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		engine config.Engine
+		from   string
+		to     string
+		want   []string
+	}{
+		{
+			name:   "postgresql",
+			engine: config.EnginePostgreSQL,
+			from: `
+				CREATE TYPE status AS ENUM ('open', 'closed');
+				CREATE TABLE authors (id bigserial PRIMARY KEY, name text, legacy int);
+				CREATE VIEW names AS SELECT name FROM authors;
+			`,
+			to: `
+				CREATE TYPE status AS ENUM ('draft', 'open', 'closed');
+				CREATE TABLE authors (id bigserial PRIMARY KEY, name text NOT NULL, state status);
+				CREATE TABLE books (id int NOT NULL, tags text[]);
+				CREATE VIEW names AS SELECT id, name FROM authors;
+			`,
+			want: []string{
+				"DROP VIEW names",
+				"ALTER TYPE status ADD VALUE 'draft' BEFORE 'open'",
+				"CREATE TABLE books (\n  id int4 NOT NULL,\n  tags text[]\n)",
+				"ALTER TABLE authors ALTER COLUMN name SET NOT NULL",
+				"ALTER TABLE authors ADD COLUMN state status",
+				"ALTER TABLE authors DROP COLUMN legacy",
+				"CREATE VIEW names AS SELECT id, name FROM authors",
+			},
+		},
+		{
+			name:   "mysql",
+			engine: config.EngineMySQL,
+			from:   "CREATE TABLE authors (id int NOT NULL, state enum('open') NOT NULL);",
+			to:     "CREATE TABLE authors (id bigint NOT NULL, state enum('open', 'closed') NOT NULL);",
+			want: []string{
+				"ALTER TABLE authors MODIFY COLUMN id bigint NOT NULL",
+				"ALTER TABLE authors MODIFY COLUMN state enum('open','closed') NOT NULL",
+			},
+		},
+		{
+			name:   "sqlite",
+			engine: config.EngineSQLite,
+			from:   "CREATE TABLE authors (id integer NOT NULL, name text);",
+			to:     "CREATE TABLE authors (id integer NOT NULL, name text NOT NULL, bio text);",
+			want: []string{
+				"-- authors.name: SQLite can't change the type, nullability or default of a column without rebuilding the table",
+				"ALTER TABLE authors ADD COLUMN bio text",
+			},
+		},
+		{
+			name:   "postgresql constraints",
+			engine: config.EnginePostgreSQL,
+			from: `
+				CREATE TABLE a (id int PRIMARY KEY, name text);
+				CREATE TABLE c (id int, a_id int REFERENCES a(id), n int DEFAULT 1);
+				CREATE INDEX c_n ON c (n);
+			`,
+			to: `
+				CREATE TABLE a (id int PRIMARY KEY, name text UNIQUE);
+				CREATE TABLE b (id INTEGER PRIMARY KEY, a_id INTEGER REFERENCES a(id), created timestamp NOT NULL DEFAULT now());
+				CREATE INDEX b_a_id ON b (a_id);
+				CREATE TABLE c (id int, a_id int, n int DEFAULT 2);
+			`,
+			want: []string{
+				"CREATE TABLE b (\n  id int4 NOT NULL,\n  a_id int4,\n  created timestamp NOT NULL DEFAULT now(),\n  CONSTRAINT b_pkey PRIMARY KEY (id),\n  CONSTRAINT b_a_id_fkey FOREIGN KEY (a_id) REFERENCES a (id)\n)",
+				"CREATE INDEX b_a_id ON b (a_id)",
+				"ALTER TABLE a ADD CONSTRAINT a_name_key UNIQUE (name)",
+				"ALTER TABLE c DROP CONSTRAINT c_a_id_fkey",
+				"DROP INDEX c_n",
+				"ALTER TABLE c ALTER COLUMN n SET DEFAULT 2",
+			},
+		},
+		{
+			name:   "mysql constraints",
+			engine: config.EngineMySQL,
+			from: `
+				CREATE TABLE a (id int PRIMARY KEY, name text);
+				CREATE TABLE c (id int, a_id int, n int DEFAULT 1, CONSTRAINT c_a FOREIGN KEY (a_id) REFERENCES a (id));
+				CREATE INDEX c_n ON c (n);
+			`,
+			to: `
+				CREATE TABLE a (id int PRIMARY KEY, name varchar(255), UNIQUE KEY a_name (name));
+				CREATE TABLE b (id int PRIMARY KEY, a_id int, state varchar(10) NOT NULL DEFAULT 'new', CONSTRAINT b_a FOREIGN KEY (a_id) REFERENCES a (id));
+				CREATE TABLE c (id int, a_id int, n int DEFAULT 2);
+			`,
+			want: []string{
+				"CREATE TABLE b (\n  id int NOT NULL,\n  a_id int,\n  state varchar(10) NOT NULL DEFAULT 'new',\n  PRIMARY KEY (id),\n  CONSTRAINT b_a FOREIGN KEY (a_id) REFERENCES a (id)\n)",
+				"ALTER TABLE a MODIFY COLUMN name varchar(255)",
+				"ALTER TABLE a ADD CONSTRAINT a_name UNIQUE (name)",
+				"ALTER TABLE c DROP FOREIGN KEY c_a",
+				"DROP INDEX c_n ON c",
+				"ALTER TABLE c MODIFY COLUMN n int DEFAULT 2",
+			},
+		},
+		{
+			name:   "sqlite constraints",
+			engine: config.EngineSQLite,
+			from: `
+				CREATE TABLE a (id INTEGER PRIMARY KEY, name TEXT);
+				CREATE TABLE c (id INTEGER, n INTEGER DEFAULT 1);
+			`,
+			to: `
+				CREATE TABLE a (id INTEGER PRIMARY KEY, name TEXT, UNIQUE (name));
+				CREATE TABLE c (id INTEGER, n INTEGER DEFAULT 2, a_id INTEGER REFERENCES a(id));
+				CREATE INDEX c_a_id ON c (a_id);
+			`,
+			want: []string{
+				"-- a: SQLite can't add the constraint UNIQUE (name) without rebuilding the table",
+				"-- c.n: SQLite can't change the type, nullability or default of a column without rebuilding the table",
+				"ALTER TABLE c ADD COLUMN a_id integer REFERENCES a (id)",
+				"CREATE INDEX c_a_id ON c (a_id)",
+			},
+		},
+		{
+			name:   "sqlite hidden columns",
+			engine: config.EngineSQLite,
+			from:   "CREATE TABLE a (id INTEGER PRIMARY KEY);",
+			to: `
+				CREATE TABLE a (id INTEGER PRIMARY KEY, name TEXT NOT NULL DEFAULT '');
+				CREATE TABLE b (id INTEGER PRIMARY KEY, a_id INTEGER REFERENCES a(id));
+			`,
+			want: []string{
+				"CREATE TABLE b (\n  id integer NOT NULL,\n  a_id integer,\n  PRIMARY KEY (id),\n  FOREIGN KEY (a_id) REFERENCES a (id)\n)",
+				"ALTER TABLE a ADD COLUMN name text NOT NULL DEFAULT ''",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			from := load(t, tc.engine, tc.from)
+			to := load(t, tc.engine, tc.to)
+			if diff := cmp.Diff(tc.want, Diff(tc.engine, from, to)); diff != "" {
+				t.Errorf("diff mismatch:\n%s", diff)
+			}
+			if stmts := Diff(tc.engine, to, to); len(stmts) > 0 {
+				t.Errorf("expected no changes, got %q", stmts)
+			}
+		})
+	}
+}
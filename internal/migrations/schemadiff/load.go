@@ -0,0 +1,177 @@
+package schemadiff
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/engine/dolphin"
+	"github.com/sqlc-dev/sqlc/internal/engine/postgresql"
+	"github.com/sqlc-dev/sqlc/internal/engine/sqlite"
+	"github.com/sqlc-dev/sqlc/internal/migrations"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+// Schema is a catalog together with the statements that created the objects
+// the catalog can't reproduce on its own. The catalog stores the output
+// columns of a view and the signature of a function, but not their bodies.
+type Schema struct {
+	Catalog *catalog.Catalog
+
+	// Keyed by qualified name, e.g. public.active_users
+	Views map[string]string
+	// Keyed by qualified signature, e.g. public.add(int4,int4)
+	Funcs map[string]string
+}
+
+// Load builds the schema described by the files in schema, the same way
+// `sqlc generate` builds its catalog. The database configured for conf, if
+// any, isn't used.
+func Load(ctx context.Context, conf config.SQL, combo config.CombinedSettings, schema []string) (*Schema, error) {
+	conf.Database = nil
+	conf.Schema = schema
+	c, err := compiler.NewCompiler(conf, combo)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close(ctx)
+	if err := c.ParseCatalog(schema); err != nil {
+		return nil, err
+	}
+
+	var parser compiler.Parser
+	switch conf.Engine {
+	case config.EngineSQLite:
+		parser = sqlite.NewParser()
+	case config.EngineMySQL:
+		parser = dolphin.NewParser()
+	case config.EnginePostgreSQL:
+		parser = postgresql.NewParser()
+	default:
+		return nil, fmt.Errorf("unknown engine: %s", conf.Engine)
+	}
+
+	s := &Schema{
+		Catalog: c.Catalog(),
+		Views:   map[string]string{},
+		Funcs:   map[string]string{},
+	}
+	files, err := sqlpath.Glob(schema)
+	if err != nil {
+		return nil, err
+	}
+	files, err = migrations.Order(files)
+	if err != nil {
+		return nil, err
+	}
+	for _, filename := range files {
+		blob, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		contents := migrations.RemoveRollbackStatements(string(blob))
+		stmts, err := parser.Parse(strings.NewReader(contents))
+		if err != nil {
+			return nil, err
+		}
+		for _, stmt := range stmts {
+			s.update(stmt.Raw, contents)
+		}
+	}
+	return s, nil
+}
+
+func (s *Schema) update(raw *ast.RawStmt, contents string) {
+	if raw == nil {
+		return
+	}
+	switch n := raw.Stmt.(type) {
+
+	case *ast.ViewStmt:
+		name := s.qualify(optional(n.View.Schemaname), optional(n.View.Relname))
+		s.Views[name] = statementText(raw, contents)
+
+	case *ast.CreateFunctionStmt:
+		var args []*ast.TypeName
+		if n.Params != nil {
+			for _, item := range n.Params.Items {
+				param, ok := item.(*ast.FuncParam)
+				if !ok {
+					continue
+				}
+				switch param.Mode {
+				case ast.FuncParamOut, ast.FuncParamTable:
+					continue
+				}
+				args = append(args, param.Type)
+			}
+		}
+		s.Funcs[s.signature(n.Func, args)] = statementText(raw, contents)
+
+	case *ast.DropTableStmt:
+		for _, tbl := range n.Tables {
+			delete(s.Views, s.qualify(tbl.Schema, tbl.Name))
+		}
+
+	case *ast.DropFunctionStmt:
+		for _, spec := range n.Funcs {
+			if spec.HasArgs {
+				delete(s.Funcs, s.signature(spec.Name, spec.Args))
+				continue
+			}
+			prefix := s.qualify(spec.Name.Schema, spec.Name.Name) + "("
+			for key := range s.Funcs {
+				if strings.HasPrefix(key, prefix) {
+					delete(s.Funcs, key)
+				}
+			}
+		}
+
+	}
+}
+
+func (s *Schema) qualify(schema, name string) string {
+	if schema == "" {
+		schema = s.Catalog.DefaultSchema
+	}
+	return schema + "." + name
+}
+
+func (s *Schema) signature(fn *ast.FuncName, args []*ast.TypeName) string {
+	types := make([]string, len(args))
+	for i, arg := range args {
+		types[i] = typeName(s.Catalog.DefaultSchema, arg)
+	}
+	return s.qualify(fn.Schema, fn.Name) + "(" + strings.Join(types, ",") + ")"
+}
+
+func optional(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// statementText returns the source of a statement without the comments that
+// precede it or the trailing semicolon.
+func statementText(raw *ast.RawStmt, contents string) string {
+	end := len(contents)
+	if raw.StmtLen > 0 {
+		end = min(raw.StmtLocation+raw.StmtLen, end)
+	}
+	lines := strings.Split(contents[raw.StmtLocation:end], "\n")
+	for len(lines) > 0 {
+		line := strings.TrimSpace(lines[0])
+		if line != "" && !strings.HasPrefix(line, "--") {
+			break
+		}
+		lines = lines[1:]
+	}
+	text := strings.TrimSpace(strings.Join(lines, "\n"))
+	return strings.TrimSpace(strings.TrimSuffix(text, ";"))
+}
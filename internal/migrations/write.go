@@ -0,0 +1,126 @@
+package migrations
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// File is a migration file, named relative to its migration directory
+type File struct {
+	Name     string
+	Contents string
+}
+
+// timestamps look like 20060102150405
+const timestampLayout = "20060102150405"
+
+func isTimestamp(v version) bool {
+	return len(v) == 1 && v[0] >= 1e13
+}
+
+// nextVersion returns the version for a new migration. Directories that
+// number their migrations sequentially keep doing so, using the width of the
+// latest migration, while empty directories and directories using timestamps
+// get a timestamp.
+func nextVersion(tool Tool, existing []string, now time.Time) string {
+	var latest entry
+	for _, path := range existing {
+		e := newEntry(tool, path)
+		if e.versioned && (!latest.versioned || compareVersions(e.version, latest.version) > 0) {
+			latest = e
+		}
+	}
+	switch {
+	case tool == ToolFlyway:
+		if !latest.versioned {
+			return "1"
+		}
+		return strconv.FormatUint(latest.version[0]+1, 10)
+	case !latest.versioned && tool == ToolTern:
+		return "001"
+	case !latest.versioned, isTimestamp(latest.version):
+		return now.UTC().Format(timestampLayout)
+	}
+	width := len(numericPrefix.FindString(filepath.Base(latest.path)))
+	return fmt.Sprintf("%0*d", width, latest.version[0]+1)
+}
+
+// Statements that contain semicolons, like function bodies, have to be
+// wrapped so that goose and sql-migrate don't split them.
+func joinStatements(stmts []string, begin, end string) string {
+	var b strings.Builder
+	for _, stmt := range stmts {
+		wrap := begin != "" && strings.Contains(stmt, ";")
+		if wrap {
+			b.WriteString(begin + "\n")
+		}
+		b.WriteString(stmt)
+		if !strings.HasPrefix(stmt, "--") {
+			b.WriteString(";")
+		}
+		b.WriteString("\n")
+		if wrap {
+			b.WriteString(end + "\n")
+		}
+	}
+	return b.String()
+}
+
+// NewFiles returns the files for a new migration in the layout expected by
+// tool. Tools that keep up and down migrations in separate files get two
+// files, while atlas and unknown tools only get the up migration.
+func NewFiles(tool Tool, existing []string, name string, now time.Time, up, down []string) []File {
+	v := nextVersion(tool, existing, now)
+	switch tool {
+	case ToolGolangMigrate:
+		return []File{
+			{Name: v + "_" + name + ".up.sql", Contents: joinStatements(up, "", "")},
+			{Name: v + "_" + name + ".down.sql", Contents: joinStatements(down, "", "")},
+		}
+	case ToolFlyway:
+		return []File{
+			{Name: "V" + v + "__" + name + ".sql", Contents: joinStatements(up, "", "")},
+			{Name: "U" + v + "__" + name + ".sql", Contents: joinStatements(down, "", "")},
+		}
+	case ToolGoose:
+		return []File{{
+			Name: v + "_" + name + ".sql",
+			Contents: "-- +goose Up\n" +
+				joinStatements(up, "-- +goose StatementBegin", "-- +goose StatementEnd") +
+				"\n-- +goose Down\n" +
+				joinStatements(down, "-- +goose StatementBegin", "-- +goose StatementEnd"),
+		}}
+	case ToolSQLMigrate:
+		return []File{{
+			Name: v + "-" + name + ".sql",
+			Contents: "-- +migrate Up\n" +
+				joinStatements(up, "-- +migrate StatementBegin", "-- +migrate StatementEnd") +
+				"\n-- +migrate Down\n" +
+				joinStatements(down, "-- +migrate StatementBegin", "-- +migrate StatementEnd"),
+		}}
+	case ToolDbmate:
+		return []File{{
+			Name:     v + "_" + name + ".sql",
+			Contents: "-- migrate:up\n" + joinStatements(up, "", "") + "\n-- migrate:down\n" + joinStatements(down, "", ""),
+		}}
+	case ToolTern:
+		return []File{{
+			Name:     v + "_" + name + ".sql",
+			Contents: joinStatements(up, "", "") + "\n---- create above / drop below ----\n\n" + joinStatements(down, "", ""),
+		}}
+	default:
+		return []File{{
+			Name:     v + "_" + name + ".sql",
+			Contents: joinStatements(up, "", ""),
+		}}
+	}
+}
+
+// Join returns statements as the contents of a migration file without up or
+// down markers.
+func Join(stmts []string) string {
+	return joinStatements(stmts, "", "")
+}
@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewFiles(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	up := []string{"ALTER TABLE authors ADD COLUMN bio text"}
+	down := []string{"ALTER TABLE authors DROP COLUMN bio"}
+
+	for _, tc := range []struct {
+		name     string
+		tool     Tool
+		existing []string
+		want     []File
+	}{
+		{
+			name:     "goose sequential",
+			tool:     ToolGoose,
+			existing: []string{"db/00009_init.sql"},
+			want: []File{{
+				Name:     "00010_bio.sql",
+				Contents: "-- +goose Up\nALTER TABLE authors ADD COLUMN bio text;\n\n-- +goose Down\nALTER TABLE authors DROP COLUMN bio;\n",
+			}},
+		},
+		{
+			name:     "golang-migrate timestamp",
+			tool:     ToolGolangMigrate,
+			existing: []string{"db/20230101000000_init.up.sql"},
+			want: []File{
+				{Name: "20240102150405_bio.up.sql", Contents: "ALTER TABLE authors ADD COLUMN bio text;\n"},
+				{Name: "20240102150405_bio.down.sql", Contents: "ALTER TABLE authors DROP COLUMN bio;\n"},
+			},
+		},
+		{
+			name:     "flyway",
+			tool:     ToolFlyway,
+			existing: []string{"db/V1__init.sql", "db/V1.1__users.sql"},
+			want: []File{
+				{Name: "V2__bio.sql", Contents: "ALTER TABLE authors ADD COLUMN bio text;\n"},
+				{Name: "U2__bio.sql", Contents: "ALTER TABLE authors DROP COLUMN bio;\n"},
+			},
+		},
+		{
+			name: "tern",
+			tool: ToolTern,
+			want: []File{{
+				Name:     "001_bio.sql",
+				Contents: "ALTER TABLE authors ADD COLUMN bio text;\n\n---- create above / drop below ----\n\nALTER TABLE authors DROP COLUMN bio;\n",
+			}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewFiles(tc.tool, tc.existing, "bio", now, up, down)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("files mismatch:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ import (
 //         a vet rule during evaluation
 //     dumpexplain: setting dumpexplain=1 will print the JSON-formatted output
 //         from executing EXPLAIN ... on a query during vet rule evaluation
+//     generatecache: setting generatecache=0 will disable reusing the output
+//         and compiled queries of earlier generate runs
 
 type Debug struct {
 	DumpAST              bool
@@ -26,6 +28,7 @@ type Debug struct {
 	OnlyManagedDatabases bool
 	DumpVetEnv           bool
 	DumpExplain          bool
+	GenerateCache        bool
 }
 
 func DebugFromEnv() Debug {
@@ -35,6 +38,7 @@ func DebugFromEnv() Debug {
 func DebugFromString(val string) Debug {
 	d := Debug{
 		ProcessPlugins: true,
+		GenerateCache:  true,
 	}
 	if val == "" {
 		return d
@@ -61,6 +65,8 @@ func DebugFromString(val string) Debug {
 			d.DumpVetEnv = true
 		case pair == "dumpexplain=1":
 			d.DumpExplain = true
+		case pair == "generatecache=0":
+			d.GenerateCache = false
 		}
 	}
 	return d
@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	CodegenService_Generate_FullMethodName = "/plugin.CodegenService/Generate"
+	CodegenService_Vet_FullMethodName      = "/plugin.CodegenService/Vet"
 )
 
 // CodegenServiceClient is the client API for CodegenService service.
@@ -27,6 +28,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CodegenServiceClient interface {
 	Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error)
+	Vet(ctx context.Context, in *VetRequest, opts ...grpc.CallOption) (*VetResponse, error)
 }
 
 type codegenServiceClient struct {
@@ -46,11 +48,21 @@ func (c *codegenServiceClient) Generate(ctx context.Context, in *GenerateRequest
 	return out, nil
 }
 
+func (c *codegenServiceClient) Vet(ctx context.Context, in *VetRequest, opts ...grpc.CallOption) (*VetResponse, error) {
+	out := new(VetResponse)
+	err := c.cc.Invoke(ctx, CodegenService_Vet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CodegenServiceServer is the server API for CodegenService service.
 // All implementations must embed UnimplementedCodegenServiceServer
 // for forward compatibility
 type CodegenServiceServer interface {
 	Generate(context.Context, *GenerateRequest) (*GenerateResponse, error)
+	Vet(context.Context, *VetRequest) (*VetResponse, error)
 	mustEmbedUnimplementedCodegenServiceServer()
 }
 
@@ -61,6 +73,9 @@ type UnimplementedCodegenServiceServer struct {
 func (UnimplementedCodegenServiceServer) Generate(context.Context, *GenerateRequest) (*GenerateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Generate not implemented")
 }
+func (UnimplementedCodegenServiceServer) Vet(context.Context, *VetRequest) (*VetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vet not implemented")
+}
 func (UnimplementedCodegenServiceServer) mustEmbedUnimplementedCodegenServiceServer() {}
 
 // UnsafeCodegenServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +107,24 @@ func _CodegenService_Generate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CodegenService_Vet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodegenServiceServer).Vet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CodegenService_Vet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodegenServiceServer).Vet(ctx, req.(*VetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CodegenService_ServiceDesc is the grpc.ServiceDesc for CodegenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +136,10 @@ var CodegenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Generate",
 			Handler:    _CodegenService_Generate_Handler,
 		},
+		{
+			MethodName: "Vet",
+			Handler:    _CodegenService_Vet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "plugin/codegen.proto",
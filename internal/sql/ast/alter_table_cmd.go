@@ -6,6 +6,8 @@ const (
 	AT_DropColumn
 	AT_DropNotNull
 	AT_SetNotNull
+	AT_AddConstraint
+	AT_DropConstraint
 )
 
 type AlterTableType int
@@ -22,18 +24,24 @@ func (t AlterTableType) String() string {
 		return "DropNotNull"
 	case AT_SetNotNull:
 		return "SetNotNull"
+	case AT_AddConstraint:
+		return "AddConstraint"
+	case AT_DropConstraint:
+		return "DropConstraint"
 	default:
 		return "Unknown"
 	}
 }
 
 type AlterTableCmd struct {
-	Subtype   AlterTableType
-	Name      *string
-	Def       *ColumnDef
-	Newowner  *RoleSpec
-	Behavior  DropBehavior
-	MissingOk bool
+	Subtype AlterTableType
+	Name    *string
+	Def     *ColumnDef
+	// Set for AT_AddConstraint
+	Constraint *Constraint
+	Newowner   *RoleSpec
+	Behavior   DropBehavior
+	MissingOk  bool
 }
 
 func (n *AlterTableCmd) Pos() int {
@@ -10,6 +10,12 @@ type ColumnDef struct {
 	Vals       *List
 	Length     *int
 	PrimaryKey bool
+	// Hidden columns, like the rank of an FTS table, aren't part of SELECT *
+	IsHidden bool
+	// Generated columns are computed from other columns and can't be written
+	IsGenerated bool
+	// Default is the SQL text of the column's default value, if any
+	Default string
 
 	// From pg.ColumnDef
 	Inhcount      int
@@ -5,3 +5,17 @@ type ConstrType uint
 func (n *ConstrType) Pos() int {
 	return 0
 }
+
+const (
+	ConstrTypeUndefined ConstrType = 0
+	ConstrNull          ConstrType = 1
+	ConstrNotNull       ConstrType = 2
+	ConstrDefault       ConstrType = 3
+	ConstrIdentity      ConstrType = 4
+	ConstrGenerated     ConstrType = 5
+	ConstrCheck         ConstrType = 6
+	ConstrPrimary       ConstrType = 7
+	ConstrUnique        ConstrType = 8
+	ConstrExclusion     ConstrType = 9
+	ConstrForeign       ConstrType = 10
+)
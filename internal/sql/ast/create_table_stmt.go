@@ -7,6 +7,9 @@ type CreateTableStmt struct {
 	ReferTable  *TableName
 	Comment     string
 	Inherits    []*TableName
+	// Primary key, unique and foreign key constraints, including the ones
+	// declared on a single column
+	Constraints []*Constraint
 }
 
 func (n *CreateTableStmt) Pos() int {
@@ -4,7 +4,9 @@ type DeleteStmt struct {
 	Relations     *List
 	UsingClause   *List
 	WhereClause   Node
+	SortClause    *List
 	LimitCount    Node
+	LimitOffset   Node
 	ReturningList *List
 	WithClause    *WithClause
 }
@@ -33,11 +35,21 @@ func (n *DeleteStmt) Format(buf *TrackedBuffer) {
 		buf.astFormat(n.WhereClause)
 	}
 
+	if items(n.SortClause) {
+		buf.WriteString(" ORDER BY ")
+		buf.astFormat(n.SortClause)
+	}
+
 	if set(n.LimitCount) {
 		buf.WriteString(" LIMIT ")
 		buf.astFormat(n.LimitCount)
 	}
 
+	if set(n.LimitOffset) {
+		buf.WriteString(" OFFSET ")
+		buf.astFormat(n.LimitOffset)
+	}
+
 	if items(n.ReturningList) {
 		buf.WriteString(" RETURNING ")
 		buf.astFormat(n.ReturningList)
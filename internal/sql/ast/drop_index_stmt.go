@@ -0,0 +1,12 @@
+package ast
+
+type DropIndexStmt struct {
+	IfExists bool
+	Indexes  []*TableName
+	// MySQL index names are scoped to a table: DROP INDEX name ON table
+	Table *TableName
+}
+
+func (n *DropIndexStmt) Pos() int {
+	return 0
+}
@@ -7,15 +7,28 @@ import (
 )
 
 func Join(list *ast.List, sep string) string {
+	return strings.Join(Strings(list), sep)
+}
+
+// Strings returns the values of the String nodes in list
+func Strings(list *ast.List) []string {
 	if list == nil {
-		return ""
+		return nil
 	}
-
 	var items []string
 	for _, item := range list.Items {
 		if n, ok := item.(*ast.String); ok {
 			items = append(items, n.Str)
 		}
 	}
-	return strings.Join(items, sep)
+	return items
+}
+
+// StringList returns a list of String nodes holding items
+func StringList(items []string) *ast.List {
+	list := &ast.List{}
+	for _, item := range items {
+		list.Items = append(list.Items, &ast.String{Str: item})
+	}
+	return list
 }
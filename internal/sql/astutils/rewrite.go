@@ -173,6 +173,9 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
@@ -685,6 +688,9 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "Relations", nil, n.Relations)
 		a.apply(n, "UsingClause", nil, n.UsingClause)
 		a.apply(n, "WhereClause", nil, n.WhereClause)
+		a.apply(n, "SortClause", nil, n.SortClause)
+		a.apply(n, "LimitCount", nil, n.LimitCount)
+		a.apply(n, "LimitOffset", nil, n.LimitOffset)
 		a.apply(n, "ReturningList", nil, n.ReturningList)
 		a.apply(n, "WithClause", nil, n.WithClause)
 
@@ -95,6 +95,9 @@ func Walk(f Visitor, node ast.Node) {
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
@@ -1068,9 +1071,15 @@ func Walk(f Visitor, node ast.Node) {
 		if n.WhereClause != nil {
 			Walk(f, n.WhereClause)
 		}
+		if n.SortClause != nil {
+			Walk(f, n.SortClause)
+		}
 		if n.LimitCount != nil {
 			Walk(f, n.LimitCount)
 		}
+		if n.LimitOffset != nil {
+			Walk(f, n.LimitOffset)
+		}
 		if n.ReturningList != nil {
 			Walk(f, n.ReturningList)
 		}
@@ -12,6 +12,9 @@ type Catalog struct {
 	Schemas       []*Schema
 	SearchPath    []string
 	LoadExtension func(string) *Schema
+	// IndexNamesPerTable is set for engines, like MySQL, where index names
+	// only have to be unique within a table instead of within a schema
+	IndexNamesPerTable bool
 
 	// TODO: un-export
 	Extensions map[string]struct{}
@@ -82,6 +85,9 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.CompositeTypeStmt:
 		err = c.createCompositeType(n)
 
+	case *ast.CreateDomainStmt:
+		err = c.createDomain(n)
+
 	case *ast.CreateEnumStmt:
 		err = c.createEnum(n)
 
@@ -106,6 +112,9 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.DropFunctionStmt:
 		err = c.dropFunction(n)
 
+	case *ast.DropIndexStmt:
+		err = c.dropIndex(n)
+
 	case *ast.DropSchemaStmt:
 		err = c.dropSchema(n)
 
@@ -115,6 +124,9 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 	case *ast.DropTypeStmt:
 		err = c.dropType(n)
 
+	case *ast.IndexStmt:
+		err = c.createIndex(n)
+
 	case *ast.RenameColumnStmt:
 		err = c.renameColumn(n)
 
@@ -0,0 +1,225 @@
+package catalog
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// Index describes an index on a table. Primary keys and unique constraints
+// are stored as indexes as well, since that's how databases enforce them.
+//
+// Columns lists the indexed columns in order. Columns of an expression index
+// that aren't a plain column reference are empty strings. Constraint is set
+// for indexes declared as a constraint of the table instead of by CREATE
+// INDEX.
+type Index struct {
+	Name       string
+	Columns    []string
+	Unique     bool
+	Primary    bool
+	Constraint bool
+}
+
+// ForeignKey describes a foreign key constraint from Columns to RefColumns
+// of RefTable.
+type ForeignKey struct {
+	Name       string
+	Columns    []string
+	RefTable   *ast.TableName
+	RefColumns []string
+}
+
+// PrimaryKey returns the primary key of the table, or nil if it has none
+func (table *Table) PrimaryKey() *Index {
+	for _, idx := range table.Indexes {
+		if idx.Primary {
+			return idx
+		}
+	}
+	return nil
+}
+
+func (c *Catalog) addConstraint(table *Table, con *ast.Constraint) {
+	name := ""
+	if con.Conname != nil {
+		name = *con.Conname
+	}
+	switch con.Contype {
+	case ast.ConstrPrimary:
+		table.Indexes = append(table.Indexes, &Index{
+			Name:       name,
+			Columns:    astutils.Strings(con.Keys),
+			Unique:     true,
+			Primary:    true,
+			Constraint: true,
+		})
+	case ast.ConstrUnique:
+		table.Indexes = append(table.Indexes, &Index{
+			Name:       name,
+			Columns:    astutils.Strings(con.Keys),
+			Unique:     true,
+			Constraint: true,
+		})
+	case ast.ConstrForeign:
+		if con.Pktable == nil || con.Pktable.Relname == nil {
+			return
+		}
+		ref := &ast.TableName{Name: *con.Pktable.Relname}
+		if con.Pktable.Schemaname != nil {
+			ref.Schema = *con.Pktable.Schemaname
+		}
+		fk := &ForeignKey{
+			Name:       name,
+			Columns:    astutils.Strings(con.FkAttrs),
+			RefTable:   ref,
+			RefColumns: astutils.Strings(con.PkAttrs),
+		}
+		// Without a column list, the primary key of the referenced table is used
+		if len(fk.RefColumns) == 0 {
+			if _, refTable, err := c.getTable(ref); err == nil && refTable.PrimaryKey() != nil {
+				fk.RefColumns = slices.Clone(refTable.PrimaryKey().Columns)
+			} else if ref.Name == table.Rel.Name && table.PrimaryKey() != nil {
+				fk.RefColumns = slices.Clone(table.PrimaryKey().Columns)
+			}
+		}
+		table.ForeignKeys = append(table.ForeignKeys, fk)
+	}
+}
+
+// dropConstraint removes the index or foreign key with the given name.
+// Constraints the catalog doesn't track, like CHECK constraints, are ignored.
+func (table *Table) dropConstraint(name string) {
+	table.Indexes = slices.DeleteFunc(table.Indexes, func(idx *Index) bool {
+		return idx.Name == name && (idx.Unique || idx.Primary)
+	})
+	table.ForeignKeys = slices.DeleteFunc(table.ForeignKeys, func(fk *ForeignKey) bool {
+		return fk.Name == name
+	})
+}
+
+// dropColumnReferences removes the indexes and foreign keys that use a
+// dropped column, as the database does.
+func (table *Table) dropColumnReferences(name string) {
+	table.Indexes = slices.DeleteFunc(table.Indexes, func(idx *Index) bool {
+		return slices.Contains(idx.Columns, name)
+	})
+	table.ForeignKeys = slices.DeleteFunc(table.ForeignKeys, func(fk *ForeignKey) bool {
+		return slices.Contains(fk.Columns, name)
+	})
+}
+
+func renameIn(cols []string, from, to string) {
+	for i := range cols {
+		if cols[i] == from {
+			cols[i] = to
+		}
+	}
+}
+
+// foreignKeysTo returns the foreign keys, in any table, that reference rel
+func (c *Catalog) foreignKeysTo(schema string, rel *ast.TableName) []*ForeignKey {
+	var fks []*ForeignKey
+	for _, s := range c.Schemas {
+		for _, t := range s.Tables {
+			for _, fk := range t.ForeignKeys {
+				ns := fk.RefTable.Schema
+				if ns == "" {
+					ns = c.DefaultSchema
+				}
+				if ns == schema && fk.RefTable.Name == rel.Name {
+					fks = append(fks, fk)
+				}
+			}
+		}
+	}
+	return fks
+}
+
+func (c *Catalog) createIndex(stmt *ast.IndexStmt) error {
+	if stmt.Relation == nil || stmt.Relation.Relname == nil {
+		return nil
+	}
+	rel := &ast.TableName{Name: *stmt.Relation.Relname}
+	if stmt.Relation.Schemaname != nil {
+		rel.Schema = *stmt.Relation.Schemaname
+	}
+	schema, table, err := c.getTable(rel)
+	// Indexes on relations the catalog doesn't track, like foreign tables,
+	// are ignored
+	if errors.Is(err, sqlerr.NotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	idx := &Index{Unique: stmt.Unique, Primary: stmt.Primary}
+	if stmt.Idxname != nil {
+		idx.Name = *stmt.Idxname
+	}
+	// Duplicate names aren't reported, as the catalog doesn't see every
+	// statement that renames or drops an index, like ALTER INDEX .. RENAME
+	if stmt.IfNotExists && idx.Name != "" && c.indexExists(schema, table, idx.Name) {
+		return nil
+	}
+	if stmt.IndexParams != nil {
+		for _, item := range stmt.IndexParams.Items {
+			col := ""
+			if elem, ok := item.(*ast.IndexElem); ok && elem.Name != nil {
+				col = *elem.Name
+			}
+			idx.Columns = append(idx.Columns, col)
+		}
+	}
+	table.Indexes = append(table.Indexes, idx)
+	return nil
+}
+
+// indexExists reports whether an index with the given name keeps CREATE INDEX
+// IF NOT EXISTS from creating a new index on table
+func (c *Catalog) indexExists(schema *Schema, table *Table, name string) bool {
+	tables := schema.Tables
+	if c.IndexNamesPerTable {
+		tables = []*Table{table}
+	}
+	for _, t := range tables {
+		if slices.ContainsFunc(t.Indexes, func(i *Index) bool { return i.Name == name }) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Catalog) dropIndex(stmt *ast.DropIndexStmt) error {
+	for _, name := range stmt.Indexes {
+		var tables []*Table
+		if stmt.Table != nil {
+			_, table, err := c.getTable(stmt.Table)
+			if err != nil {
+				return checkMissing(err, stmt.IfExists)
+			}
+			tables = []*Table{table}
+		} else {
+			ns := name.Schema
+			if ns == "" {
+				ns = c.DefaultSchema
+			}
+			schema, err := c.getSchema(ns)
+			if err != nil {
+				return checkMissing(err, stmt.IfExists)
+			}
+			tables = schema.Tables
+		}
+		// Indexes that were never tracked, e.g. because they were created by
+		// unsupported DDL, are ignored instead of reported as missing
+		for _, table := range tables {
+			table.Indexes = slices.DeleteFunc(table.Indexes, func(idx *Index) bool {
+				return idx.Name == name.Name
+			})
+		}
+	}
+	return nil
+}
@@ -131,3 +131,8 @@ func (c *Catalog) GetTable(rel *ast.TableName) (Table, error) {
 		return *table, err
 	}
 }
+
+// GetDomain returns the domain named rel, or nil if rel isn't a domain
+func (c *Catalog) GetDomain(rel *ast.TypeName) *Domain {
+	return c.getDomain(rel)
+}
@@ -16,6 +16,9 @@ type Table struct {
 	Rel     *ast.TableName
 	Columns []*Column
 	Comment string
+
+	Indexes     []*Index
+	ForeignKeys []*ForeignKey
 }
 
 func checkMissing(err error, missingOK bool) error {
@@ -91,6 +94,7 @@ func (c *Catalog) dropColumn(table *Table, cmd *ast.AlterTableCmd) error {
 		}
 	}
 	table.Columns = append(table.Columns[:index], table.Columns[index+1:]...)
+	table.dropColumnReferences(col.Name)
 	return nil
 }
 
@@ -128,6 +132,14 @@ type Column struct {
 	ArrayDims  int
 	Comment    string
 	Length     *int
+	// IsHidden is set for columns that can be referenced by name but aren't
+	// returned by SELECT *, such as the hidden columns of virtual tables
+	IsHidden bool
+	// IsGenerated is set for columns whose value is computed by the database,
+	// which can't be set by INSERT or UPDATE statements
+	IsGenerated bool
+	// Default is the SQL expression of the column's default value, if any
+	Default string
 
 	linkedType bool
 }
@@ -177,6 +189,10 @@ func isStmtImplemented(stmt *ast.AlterTableStmt) bool {
 				implemented = true
 			case ast.AT_SetNotNull:
 				implemented = true
+			case ast.AT_AddConstraint:
+				implemented = true
+			case ast.AT_DropConstraint:
+				implemented = true
 			}
 		}
 	}
@@ -215,6 +231,14 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 				if err := table.setNotNull(cmd); err != nil {
 					return err
 				}
+			case ast.AT_AddConstraint:
+				if cmd.Constraint != nil {
+					c.addConstraint(table, cmd.Constraint)
+				}
+			case ast.AT_DropConstraint:
+				if cmd.Name != nil {
+					table.dropConstraint(*cmd.Name)
+				}
 			}
 		}
 	}
@@ -324,20 +348,43 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 		}
 	}
 
+	for _, con := range stmt.Constraints {
+		c.addConstraint(&tbl, con)
+	}
+	// Engines that only mark primary key columns, instead of adding a
+	// constraint, still get a primary key index
+	if tbl.PrimaryKey() == nil {
+		var keys []string
+		for _, col := range stmt.Cols {
+			if col.PrimaryKey {
+				keys = append(keys, col.Colname)
+			}
+		}
+		if len(keys) > 0 {
+			tbl.Indexes = append(tbl.Indexes, &Index{Columns: keys, Unique: true, Primary: true, Constraint: true})
+		}
+	}
+
 	schema.Tables = append(schema.Tables, &tbl)
 	return nil
 }
 
 func (c *Catalog) defineColumn(table *ast.TableName, col *ast.ColumnDef) (*Column, error) {
 	tc := &Column{
-		Name:       col.Colname,
-		Type:       *col.TypeName,
-		IsNotNull:  col.IsNotNull,
-		IsUnsigned: col.IsUnsigned,
-		IsArray:    col.IsArray,
-		ArrayDims:  col.ArrayDims,
-		Comment:    col.Comment,
-		Length:     col.Length,
+		Name:        col.Colname,
+		Type:        *col.TypeName,
+		IsNotNull:   col.IsNotNull,
+		IsUnsigned:  col.IsUnsigned,
+		IsArray:     col.IsArray,
+		ArrayDims:   col.ArrayDims,
+		Comment:     col.Comment,
+		Length:      col.Length,
+		IsHidden:    col.IsHidden,
+		IsGenerated: col.IsGenerated,
+		Default:     col.Default,
+	}
+	if d := c.getDomain(col.TypeName); d != nil && d.NotNull {
+		tc.IsNotNull = true
 	}
 	if col.Vals != nil {
 		typeName := ast.TypeName{
@@ -390,7 +437,7 @@ func (c *Catalog) dropTable(stmt *ast.DropTableStmt) error {
 }
 
 func (c *Catalog) renameColumn(stmt *ast.RenameColumnStmt) error {
-	_, tbl, err := c.getTable(stmt.Table)
+	sch, tbl, err := c.getTable(stmt.Table)
 	if err != nil {
 		return checkMissing(err, stmt.MissingOk)
 	}
@@ -407,6 +454,15 @@ func (c *Catalog) renameColumn(stmt *ast.RenameColumnStmt) error {
 		return sqlerr.ColumnNotFound(tbl.Rel.Name, stmt.Col.Name)
 	}
 	tbl.Columns[idx].Name = *stmt.NewName
+	for _, index := range tbl.Indexes {
+		renameIn(index.Columns, stmt.Col.Name, *stmt.NewName)
+	}
+	for _, fk := range tbl.ForeignKeys {
+		renameIn(fk.Columns, stmt.Col.Name, *stmt.NewName)
+	}
+	for _, fk := range c.foreignKeysTo(sch.Name, tbl.Rel) {
+		renameIn(fk.RefColumns, stmt.Col.Name, *stmt.NewName)
+	}
 
 	if tbl.Columns[idx].linkedType {
 		name := fmt.Sprintf("%s_%s", tbl.Rel.Name, *stmt.NewName)
@@ -431,6 +487,9 @@ func (c *Catalog) renameTable(stmt *ast.RenameTableStmt) error {
 		return sqlerr.RelationExists(*stmt.NewName)
 	}
 	if stmt.NewName != nil {
+		for _, fk := range c.foreignKeysTo(sch.Name, tbl.Rel) {
+			fk.RefTable.Name = *stmt.NewName
+		}
 		tbl.Rel.Name = *stmt.NewName
 	}
 
@@ -3,6 +3,7 @@ package catalog
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
@@ -29,6 +30,7 @@ func (e *Enum) isType() {
 
 type CompositeType struct {
 	Name    string
+	Columns []*Column
 	Comment string
 }
 
@@ -39,6 +41,30 @@ func (ct *CompositeType) SetComment(c string) {
 	ct.Comment = c
 }
 
+// A Domain is a data type based on another type, which values must satisfy
+// the domain's constraints.
+// https://www.postgresql.org/docs/current/sql-createdomain.html
+type Domain struct {
+	Name string
+	// The base type is never a domain, domains based on other domains
+	// inherit their base type and constraints
+	BaseType  ast.TypeName
+	IsArray   bool
+	ArrayDims int
+	NotNull   bool
+	// The expressions of the CHECK constraints and of the default, as SQL
+	Checks  []string
+	Default string
+	Comment string
+}
+
+func (d *Domain) isType() {
+}
+
+func (d *Domain) SetComment(c string) {
+	d.Comment = c
+}
+
 func sameType(a, b *ast.TypeName) bool {
 	if a.Catalog != b.Catalog {
 		return false
@@ -101,6 +127,93 @@ func stringSlice(list *ast.List) []string {
 	return items
 }
 
+func (c *Catalog) createDomain(stmt *ast.CreateDomainStmt) error {
+	name, err := domainName(stmt.Domainname)
+	if err != nil {
+		return err
+	}
+	ns := name.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	tbl := &ast.TableName{
+		Name: name.Name,
+	}
+	if _, _, err := schema.getTable(tbl); err == nil {
+		return sqlerr.RelationExists(tbl.Name)
+	}
+	if _, _, err := schema.getType(name); err == nil {
+		return sqlerr.TypeExists(tbl.Name)
+	}
+	d := &Domain{
+		Name: name.Name,
+		BaseType: ast.TypeName{
+			Catalog: stmt.TypeName.Catalog,
+			Schema:  stmt.TypeName.Schema,
+			Name:    stmt.TypeName.Name,
+		},
+	}
+	if bounds := stmt.TypeName.ArrayBounds; bounds != nil && len(bounds.Items) > 0 {
+		d.IsArray = true
+		d.ArrayDims = len(bounds.Items)
+	}
+	if base := c.getDomain(&d.BaseType); base != nil {
+		d.BaseType = base.BaseType
+		d.IsArray = d.IsArray || base.IsArray
+		d.ArrayDims += base.ArrayDims
+		d.NotNull = base.NotNull
+		d.Checks = append(d.Checks, base.Checks...)
+		d.Default = base.Default
+	}
+	if stmt.Constraints != nil {
+		for _, item := range stmt.Constraints.Items {
+			con, ok := item.(*ast.Constraint)
+			if !ok {
+				continue
+			}
+			switch con.Contype {
+			case ast.ConstrNotNull:
+				d.NotNull = true
+			case ast.ConstrCheck:
+				d.Checks = append(d.Checks, ast.Format(con.RawExpr))
+			case ast.ConstrDefault:
+				d.Default = ast.Format(con.RawExpr)
+			}
+		}
+	}
+	schema.Types = append(schema.Types, d)
+	return nil
+}
+
+// domainName converts the qualified name of CREATE DOMAIN
+func domainName(list *ast.List) (*ast.TypeName, error) {
+	parts := stringSlice(list)
+	switch len(parts) {
+	case 1:
+		return &ast.TypeName{Name: parts[0]}, nil
+	case 2:
+		return &ast.TypeName{Schema: parts[0], Name: parts[1]}, nil
+	case 3:
+		return &ast.TypeName{Catalog: parts[0], Schema: parts[1], Name: parts[2]}, nil
+	default:
+		return nil, fmt.Errorf("invalid domain name: %s", strings.Join(parts, "."))
+	}
+}
+
+// getDomain returns nil if rel isn't a domain
+func (c *Catalog) getDomain(rel *ast.TypeName) *Domain {
+	typ, _, err := c.getType(rel)
+	if err != nil {
+		return nil
+	}
+	d, _ := typ.(*Domain)
+	return d
+}
+
 func (c *Catalog) getType(rel *ast.TypeName) (Type, int, error) {
 	ns := rel.Schema
 	if ns == "" {
@@ -135,9 +248,18 @@ func (c *Catalog) createCompositeType(stmt *ast.CompositeTypeStmt) error {
 	if _, _, err := schema.getType(stmt.TypeName); err == nil {
 		return sqlerr.TypeExists(tbl.Name)
 	}
-	schema.Types = append(schema.Types, &CompositeType{
+	ct := &CompositeType{
 		Name: stmt.TypeName.Name,
-	})
+	}
+	for _, col := range stmt.Cols {
+		ct.Columns = append(ct.Columns, &Column{
+			Name:      col.Colname,
+			Type:      *col.TypeName,
+			IsArray:   col.IsArray,
+			ArrayDims: col.ArrayDims,
+		})
+	}
+	schema.Types = append(schema.Types, ct)
 	return nil
 }
 
@@ -286,6 +408,15 @@ func (c *Catalog) alterTypeSetSchema(stmt *ast.AlterTypeSetSchemaStmt) error {
 				}
 			}
 		}
+		for _, typ := range schema.Types {
+			if ct, ok := typ.(*CompositeType); ok {
+				for _, column := range ct.Columns {
+					if column.Type == oldType {
+						column.Type.Schema = *stmt.NewSchema
+					}
+				}
+			}
+		}
 	}
 	return nil
 }
@@ -344,6 +475,7 @@ func (c *Catalog) renameType(stmt *ast.RenameTypeStmt) error {
 	case *CompositeType:
 		schema.Types[idx] = &CompositeType{
 			Name:    newName,
+			Columns: typ.Columns,
 			Comment: typ.Comment,
 		}
 
@@ -354,6 +486,11 @@ func (c *Catalog) renameType(stmt *ast.RenameTypeStmt) error {
 			Comment: typ.Comment,
 		}
 
+	case *Domain:
+		d := *typ
+		d.Name = newName
+		schema.Types[idx] = &d
+
 	default:
 		return fmt.Errorf("unsupported type: %T", typ)
 
@@ -368,6 +505,15 @@ func (c *Catalog) renameType(stmt *ast.RenameTypeStmt) error {
 				}
 			}
 		}
+		for _, typ := range schema.Types {
+			if ct, ok := typ.(*CompositeType); ok {
+				for _, column := range ct.Columns {
+					if column.Type == *stmt.Type {
+						column.Type.Name = newName
+					}
+				}
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,166 @@
+package rewrite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/source"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// Pagination is an expanded `sqlc.paginate(col1, col2)` call
+type Pagination struct {
+	// The result columns that make up the cursor, in sort order
+	Columns []string
+	// The names of the parameters holding the cursor, one for each column
+	AfterParams []string
+	// The name of the parameter holding the page size
+	PageSizeParam string
+}
+
+// Paginate expands `sqlc.paginate(col1, col2)` in the WHERE clause of a
+// SELECT statement into a keyset condition,
+//
+//	((col1, col2) > (sqlc.narg(after_col1), sqlc.narg(after_col2)) OR sqlc.narg(after_col1) IS NULL)
+//
+// so that the first page is requested by leaving the cursor NULL, and adds `ORDER BY col1, col2 LIMIT sqlc.arg(page_size)` to the end of the
+// statement. MySQL doesn't allow named parameters in LIMIT, so the page size
+// is a positional parameter named limit there. It returns the edited query,
+// which needs to be parsed again, or a nil Pagination if the query doesn't
+// use sqlc.paginate.
+func Paginate(engine config.Engine, raw *ast.RawStmt, query string) (string, *Pagination, error) {
+	calls := astutils.Search(raw, isPaginate)
+	if len(calls.Items) == 0 {
+		return query, nil, nil
+	}
+	call := calls.Items[0].(*ast.FuncCall)
+	if len(calls.Items) > 1 {
+		return "", nil, &sqlerr.Error{
+			Message:  "sqlc.paginate can only be used once in a query",
+			Location: calls.Items[1].(*ast.FuncCall).Location,
+		}
+	}
+
+	sel, ok := raw.Stmt.(*ast.SelectStmt)
+	if !ok || sel.WhereClause == nil || !contains(sel.WhereClause, call) {
+		return "", nil, &sqlerr.Error{
+			Message:  "sqlc.paginate can only be used in the WHERE clause of a SELECT statement",
+			Location: call.Location,
+		}
+	}
+	if items(sel.SortClause) || !isNull(sel.LimitCount) || !isNull(sel.LimitOffset) || items(sel.LockingClause) {
+		return "", nil, &sqlerr.Error{
+			Message:  "sqlc.paginate adds its own ORDER BY and LIMIT clauses and can't be used with ORDER BY, LIMIT, OFFSET or FOR UPDATE",
+			Location: call.Location,
+		}
+	}
+
+	p := &Pagination{PageSizeParam: "page_size"}
+	limit := fmt.Sprintf("sqlc.arg(%s)", p.PageSizeParam)
+	if engine == config.EngineMySQL {
+		p.PageSizeParam = "limit"
+		limit = "?"
+	}
+	var refs, after []string
+	for _, arg := range call.Args.Items {
+		ref, ok := arg.(*ast.ColumnRef)
+		if !ok {
+			return "", nil, &sqlerr.Error{
+				Message:  fmt.Sprintf("expected column references as parameters to sqlc.paginate; got %T", arg),
+				Location: call.Location,
+			}
+		}
+		fields := astutils.Join(ref.Fields, ".")
+		name := fields[strings.LastIndex(fields, ".")+1:]
+		for _, col := range p.Columns {
+			if col == name {
+				return "", nil, &sqlerr.Error{
+					Message:  fmt.Sprintf("duplicate column %q in sqlc.paginate", name),
+					Location: ref.Location,
+				}
+			}
+		}
+		p.Columns = append(p.Columns, name)
+		p.AfterParams = append(p.AfterParams, "after_"+name)
+		refs = append(refs, fields)
+		after = append(after, fmt.Sprintf("sqlc.narg(%s)", "after_"+name))
+	}
+
+	cond := refs[0] + " > " + after[0]
+	if len(refs) > 1 {
+		cond = "(" + strings.Join(refs, ", ") + ") > (" + strings.Join(after, ", ") + ")"
+	}
+	// The comparison comes first, so the parameter types are inferred from it
+	cond = "(" + cond + " OR " + after[0] + " IS NULL)"
+
+	// The trailing semicolon, if any, stays at the end of the statement
+	end := len(strings.TrimRight(query, " \t\r\n;"))
+	edits := []source.Edit{
+		{
+			Location: call.Location - raw.StmtLocation,
+			OldFunc:  callLength,
+			New:      cond,
+		},
+		{
+			Location: end,
+			New:      fmt.Sprintf("\nORDER BY %s\nLIMIT %s", strings.Join(refs, ", "), limit),
+		},
+	}
+	expanded, err := source.Mutate(query, edits)
+	if err != nil {
+		return "", nil, err
+	}
+	return expanded, p, nil
+}
+
+func isPaginate(node ast.Node) bool {
+	call, ok := node.(*ast.FuncCall)
+	if !ok || call.Func == nil {
+		return false
+	}
+	return call.Func.Schema == "sqlc" && call.Func.Name == "paginate"
+}
+
+func contains(root ast.Node, node ast.Node) bool {
+	return len(astutils.Search(root, func(n ast.Node) bool { return n == node }).Items) > 0
+}
+
+func items(l *ast.List) bool {
+	return l != nil && len(l.Items) > 0
+}
+
+func isNull(n ast.Node) bool {
+	if n == nil {
+		return true
+	}
+	_, ok := n.(*ast.TODO)
+	return ok
+}
+
+// callLength returns the length of the function call at the start of s, up
+// to and including its closing parenthesis.
+func callLength(s string) int {
+	depth := 0
+	var quote rune
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '`':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(s)
+}
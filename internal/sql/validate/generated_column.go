@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
+)
+
+// GeneratedColumns returns an error if an INSERT or UPDATE statement sets a
+// generated column. Their values are always computed by the database.
+func GeneratedColumns(c *catalog.Catalog, stmt ast.Node) error {
+	switch n := stmt.(type) {
+	case *ast.InsertStmt:
+		if n.Cols == nil {
+			return nil
+		}
+		return generatedColumns(c, "INSERT into", n.Relation, n.Cols)
+	case *ast.UpdateStmt:
+		if n.Relations == nil || len(n.Relations.Items) != 1 || n.TargetList == nil {
+			return nil
+		}
+		rv, ok := n.Relations.Items[0].(*ast.RangeVar)
+		if !ok {
+			return nil
+		}
+		return generatedColumns(c, "UPDATE", rv, n.TargetList)
+	}
+	return nil
+}
+
+func generatedColumns(c *catalog.Catalog, verb string, rv *ast.RangeVar, targets *ast.List) error {
+	if rv == nil || rv.Relname == nil {
+		return nil
+	}
+	rel := &ast.TableName{Name: *rv.Relname}
+	if rv.Schemaname != nil {
+		rel.Schema = *rv.Schemaname
+	}
+	if rv.Catalogname != nil {
+		rel.Catalog = *rv.Catalogname
+	}
+	// Unknown tables are reported when the query is resolved
+	table, err := c.GetTable(rel)
+	if err != nil {
+		return nil
+	}
+	generated := map[string]bool{}
+	for _, col := range table.Columns {
+		if col.IsGenerated {
+			generated[col.Name] = true
+		}
+	}
+	for _, item := range targets.Items {
+		target, ok := item.(*ast.ResTarget)
+		if !ok || target.Name == nil {
+			continue
+		}
+		if generated[*target.Name] {
+			return &sqlerr.Error{
+				Message:  fmt.Sprintf("cannot %s generated column %q", verb, *target.Name),
+				Location: target.Location,
+			}
+		}
+	}
+	return nil
+}
@@ -29,11 +29,22 @@ func (v *sqlcFuncVisitor) Visit(node ast.Node) astutils.Visitor {
 	// Custom validation for sqlc.arg, sqlc.narg and sqlc.slice
 	// TODO: Replace this once type-checking is implemented
 	if fn.Schema == "sqlc" {
-		if !(fn.Name == "arg" || fn.Name == "narg" || fn.Name == "slice" || fn.Name == "embed") {
+		if !(fn.Name == "arg" || fn.Name == "narg" || fn.Name == "slice" || fn.Name == "embed" || fn.Name == "paginate") {
 			v.err = sqlerr.FunctionNotFound("sqlc." + fn.Name)
 			return nil
 		}
 
+		// sqlc.paginate takes one or more columns and is checked when it's expanded
+		if fn.Name == "paginate" {
+			if call.Args == nil || len(call.Args.Items) == 0 {
+				v.err = &sqlerr.Error{
+					Message:  "expected at least 1 parameter to sqlc.paginate; got 0",
+					Location: call.Pos(),
+				}
+			}
+			return nil
+		}
+
 		if len(call.Args.Items) != 1 {
 			v.err = &sqlerr.Error{
 				Message:  fmt.Sprintf("expected 1 parameter to sqlc.%s; got %d", fn.Name, len(call.Args.Items)),
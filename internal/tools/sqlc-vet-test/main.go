@@ -0,0 +1,102 @@
+// sqlc-vet-test is a process plugin implementing vet rules, used by the
+// end-to-end tests of rules implemented by plugins.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "error running vet rule: %s", err)
+		os.Exit(2)
+	}
+}
+
+type options struct {
+	// Table names that don't have to be plural
+	Exceptions []string `json:"exceptions"`
+}
+
+func run() error {
+	if len(os.Args) < 2 || os.Args[1] != "/plugin.CodegenService/Vet" {
+		return fmt.Errorf("unsupported method: %v", os.Args[1:])
+	}
+	var req plugin.VetRequest
+	reqBlob, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+	if err := proto.Unmarshal(reqBlob, &req); err != nil {
+		return err
+	}
+	var opts options
+	if len(req.RuleOptions) > 0 {
+		if err := json.Unmarshal(req.RuleOptions, &opts); err != nil {
+			return fmt.Errorf("invalid rule options: %w", err)
+		}
+	}
+	resp := &plugin.VetResponse{}
+	switch req.Rule {
+	case "plural-tables":
+		resp.Findings = pluralTables(&req, opts)
+	case "require-limit":
+		resp.Findings = requireLimit(&req)
+	default:
+		return fmt.Errorf("unknown rule %q", req.Rule)
+	}
+	respBlob, err := proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(os.Stdout)
+	if _, err := w.Write(respBlob); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// pluralTables reports tables whose name doesn't end in an s, in the first
+// schema file of the package
+func pluralTables(req *plugin.VetRequest, opts options) []*plugin.VetFinding {
+	var findings []*plugin.VetFinding
+	for _, schema := range req.Catalog.GetSchemas() {
+		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
+			continue
+		}
+		for _, table := range schema.Tables {
+			name := table.Rel.GetName()
+			if strings.HasSuffix(name, "s") || slices.Contains(opts.Exceptions, name) {
+				continue
+			}
+			f := &plugin.VetFinding{Message: fmt.Sprintf("table %s isn't plural", name)}
+			if schemas := req.Settings.GetSchema(); len(schemas) > 0 {
+				f.File = schemas[0]
+			}
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
+
+// requireLimit reports :many queries without a LIMIT
+func requireLimit(req *plugin.VetRequest) []*plugin.VetFinding {
+	var findings []*plugin.VetFinding
+	for _, q := range req.Queries {
+		if q.Cmd != ":many" || strings.Contains(strings.ToUpper(q.Text), "LIMIT") {
+			continue
+		}
+		findings = append(findings, &plugin.VetFinding{Query: q.Name})
+	}
+	return findings
+}